@@ -0,0 +1,82 @@
+package goset
+
+import (
+	"cmp"
+	"slices"
+)
+
+// CachedSortedSet is a wrapper that caches the sorted elements of any Set implementation.
+// The sorted slice is computed lazily on the first SortedElements call and reused until
+// the set is mutated, which suits read-heavy workloads on mostly static sets.
+//
+// Note: CachedSortedSet is not thread-safe. Mutating the wrapped set directly bypasses cache invalidation.
+//
+// The zero value is not usable - use NewCachedSortedSet to create instances.
+type CachedSortedSet[T cmp.Ordered] struct {
+	Set[T]
+	sorted []T
+	valid  bool
+}
+
+// NewCachedSortedSet creates a new CachedSortedSet wrapping the provided Set.
+func NewCachedSortedSet[T cmp.Ordered](set Set[T]) *CachedSortedSet[T] {
+	return &CachedSortedSet[T]{Set: set}
+}
+
+// SortedElements returns a slice containing all set elements in ascending order.
+// The returned slice is a copy and may be modified by the caller.
+//
+// Time complexity: O(n log n) on the first call after a mutation, O(n) otherwise.
+func (set *CachedSortedSet[T]) SortedElements() []T {
+	if !set.valid {
+		set.sorted = slices.Sorted(set.Set.All())
+		set.valid = true
+	}
+	return slices.Clone(set.sorted)
+}
+
+// Add inserts the element into the set. Invalidates the cache if the element is new.
+func (set *CachedSortedSet[T]) Add(element T) {
+	if !set.Set.Contains(element) {
+		set.invalidate()
+		set.Set.Add(element)
+	}
+}
+
+// Remove deletes the element from the set. Invalidates the cache if the element was present.
+func (set *CachedSortedSet[T]) Remove(element T) {
+	if set.Set.Contains(element) {
+		set.invalidate()
+		set.Set.Remove(element)
+	}
+}
+
+// Merge adds all elements from the other set to this set (in-place union). Invalidates the cache.
+func (set *CachedSortedSet[T]) Merge(other Set[T]) {
+	set.invalidate()
+	set.Set.Merge(other)
+}
+
+// Retain keeps only elements present in both sets (in-place intersection). Invalidates the cache.
+func (set *CachedSortedSet[T]) Retain(other Set[T]) {
+	set.invalidate()
+	set.Set.Retain(other)
+}
+
+// Subtract removes all elements present in the other set from this set (in-place difference). Invalidates the cache.
+func (set *CachedSortedSet[T]) Subtract(other Set[T]) {
+	set.invalidate()
+	set.Set.Subtract(other)
+}
+
+// Xor replaces this set with elements present in exactly one set (in-place symmetric difference). Invalidates the cache.
+func (set *CachedSortedSet[T]) Xor(other Set[T]) {
+	set.invalidate()
+	set.Set.Xor(other)
+}
+
+// invalidate drops the cached sorted elements.
+func (set *CachedSortedSet[T]) invalidate() {
+	set.sorted = nil
+	set.valid = false
+}
@@ -0,0 +1,48 @@
+package goset
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCachedSortedSet(t *testing.T) {
+	s := NewCachedSortedSet[int](NewHashSet(3, 1, 2))
+	check := func(step string, want []int) {
+		t.Helper()
+		if got := s.SortedElements(); !slices.Equal(got, want) {
+			t.Errorf("%s: SortedElements = %v, want %v", step, got, want)
+		}
+		if !s.valid {
+			t.Errorf("%s: cache not populated after SortedElements", step)
+		}
+	}
+
+	check("initial", []int{1, 2, 3})
+	s.SortedElements()[0] = 100
+	check("after modifying returned slice", []int{1, 2, 3})
+
+	s.Add(2)
+	if !s.valid {
+		t.Error("adding an existing element invalidated the cache")
+	}
+	s.Add(0)
+	if s.valid {
+		t.Error("Add did not invalidate the cache")
+	}
+	check("after Add", []int{0, 1, 2, 3})
+
+	s.Remove(2)
+	if s.valid {
+		t.Error("Remove did not invalidate the cache")
+	}
+	check("after Remove", []int{0, 1, 3})
+
+	s.Merge(NewHashSet(5, 4))
+	check("after Merge", []int{0, 1, 3, 4, 5})
+	s.Retain(NewHashSet(1, 3, 4, 5, 9))
+	check("after Retain", []int{1, 3, 4, 5})
+	s.Subtract(NewHashSet(4))
+	check("after Subtract", []int{1, 3, 5})
+	s.Xor(NewHashSet(3, 7))
+	check("after Xor", []int{1, 5, 7})
+}
@@ -0,0 +1,58 @@
+package goset
+
+// Chain is a fluent wrapper for composing set operations in a single expression:
+//
+//	result := NewChain(a).Union(b).Intersection(c).Filter(isEven).Build()
+//
+// Every step produces a new intermediate set, so neither the starting set nor any operand is mutated.
+//
+// The zero value is not usable - use NewChain to create instances.
+type Chain[T comparable] struct {
+	set Set[T]
+}
+
+// NewChain starts a chain from a copy of the provided set.
+func NewChain[T comparable](set Set[T]) *Chain[T] {
+	return &Chain[T]{set: set.Clone()}
+}
+
+// Union replaces the chained set with its union with the other set.
+func (chain *Chain[T]) Union(other Set[T]) *Chain[T] {
+	chain.set = chain.set.Union(other)
+	return chain
+}
+
+// Intersection replaces the chained set with its intersection with the other set.
+func (chain *Chain[T]) Intersection(other Set[T]) *Chain[T] {
+	chain.set = chain.set.Intersection(other)
+	return chain
+}
+
+// Difference replaces the chained set with its difference with the other set.
+func (chain *Chain[T]) Difference(other Set[T]) *Chain[T] {
+	chain.set = chain.set.Difference(other)
+	return chain
+}
+
+// SymmetricDifference replaces the chained set with its symmetric difference with the other set.
+func (chain *Chain[T]) SymmetricDifference(other Set[T]) *Chain[T] {
+	chain.set = chain.set.SymmetricDifference(other)
+	return chain
+}
+
+// Filter keeps only elements satisfying the predicate.
+func (chain *Chain[T]) Filter(predicate func(T) bool) *Chain[T] {
+	newset := NewHashSet[T]()
+	for element := range chain.set.All() {
+		if predicate(element) {
+			newset.Add(element)
+		}
+	}
+	chain.set = newset
+	return chain
+}
+
+// Build returns the resulting set.
+func (chain *Chain[T]) Build() Set[T] {
+	return chain.set
+}
@@ -0,0 +1,34 @@
+package goset
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestChain(t *testing.T) {
+	a := NewHashSet(1, 2, 3, 4)
+	b := NewHashSet(5, 6)
+	c := NewHashSet(2, 4, 5, 6, 7)
+	isEven := func(x int) bool { return x%2 == 0 }
+
+	got := NewChain[int](a).Union(b).Intersection(c).Filter(isEven).Build()
+
+	step := a.Union(b).Intersection(c)
+	want := NewHashSet[int]()
+	for element := range step.All() {
+		if isEven(element) {
+			want.Add(element)
+		}
+	}
+	if !got.Equals(want) {
+		t.Errorf("chained result = %v, want %v", got, want)
+	}
+	if a.Len() != 4 {
+		t.Errorf("starting set was mutated: %v", a)
+	}
+
+	diff := NewChain[int](a).Difference(NewHashSet(1)).SymmetricDifference(NewHashSet(4, 9)).Build()
+	if got, want := sortedElements(diff), []int{2, 3, 9}; !slices.Equal(got, want) {
+		t.Errorf("Difference/SymmetricDifference chain = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,89 @@
+package goset
+
+// Op identifies the kind of operation recorded in a Change.
+type Op int
+
+const (
+	// OpAdd inserts the element into the set.
+	OpAdd Op = iota
+	// OpRemove deletes the element from the set.
+	OpRemove
+)
+
+// String returns "Add" or "Remove".
+func (op Op) String() string {
+	switch op {
+	case OpAdd:
+		return "Add"
+	case OpRemove:
+		return "Remove"
+	default:
+		return "Op(?)"
+	}
+}
+
+// Change is a single operation of a changelog produced by Changelog.
+type Change[T comparable] struct {
+	Op      Op
+	Element T
+}
+
+// Changelog returns the sequence of operations that transforms the from set into the to set.
+// All removals come first, followed by all additions, so the set never grows beyond
+// max(|from|, |to|) while the changelog is applied. The order within each group is undefined.
+//
+// Time complexity: O(n * c1 + m * c2) where n and m are the sizes of from and to
+// and c1, c2 are time complexities of to's and from's Contains() methods.
+func Changelog[T comparable](from, to Set[T]) []Change[T] {
+	var changes []Change[T]
+	for element := range from.All() {
+		if !to.Contains(element) {
+			changes = append(changes, Change[T]{Op: OpRemove, Element: element})
+		}
+	}
+	for element := range to.All() {
+		if !from.Contains(element) {
+			changes = append(changes, Change[T]{Op: OpAdd, Element: element})
+		}
+	}
+	return changes
+}
+
+// ApplyChangelog applies the changes to the set in place, in order.
+// Redundant operations are harmless: adding a present element or removing an absent one is a no-op.
+//
+// Time complexity: O(k * c) where k is the number of changes and c is time complexity of the set's Add() and Remove() methods.
+func ApplyChangelog[T comparable](s Set[T], changes []Change[T]) {
+	for _, change := range changes {
+		switch change.Op {
+		case OpAdd:
+			s.Add(change.Element)
+		case OpRemove:
+			s.Remove(change.Element)
+		}
+	}
+}
+
+// ChangeDetector reports how a polled set changed since the previous poll.
+// It keeps a snapshot of the last observed contents; before the first Update the snapshot is empty.
+//
+// The zero value is ready to use.
+type ChangeDetector[T comparable] struct {
+	previous HashSet[T]
+}
+
+// Update compares the current set with the previous snapshot, returning the elements added
+// and removed since the last call, and stores a copy of current as the new snapshot.
+// The first call reports every element as added.
+//
+// Time complexity: O(n + m) where n and m are the sizes of the previous and current sets.
+func (detector *ChangeDetector[T]) Update(current Set[T]) (added, removed Set[T]) {
+	snapshot := make(HashSet[T], current.Len())
+	for element := range current.All() {
+		snapshot[element] = struct{}{}
+	}
+	newlyAdded := DifferenceHashSet(snapshot, detector.previous)
+	newlyRemoved := DifferenceHashSet(detector.previous, snapshot)
+	detector.previous = snapshot
+	return &newlyAdded, &newlyRemoved
+}
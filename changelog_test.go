@@ -0,0 +1,110 @@
+package goset
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestChangelog(t *testing.T) {
+	from := NewHashSet(1, 2, 3)
+	to := NewHashSet(2, 3, 4, 5)
+	changes := Changelog[int](from, to)
+
+	if len(changes) != 3 {
+		t.Fatalf("Changelog returned %d changes, want 3: %v", len(changes), changes)
+	}
+	seenAdd := false
+	for _, change := range changes {
+		switch change.Op {
+		case OpAdd:
+			seenAdd = true
+		case OpRemove:
+			if seenAdd {
+				t.Errorf("removal of %v follows an addition, want all removals first", change.Element)
+			}
+		}
+	}
+
+	result := from.Clone()
+	for _, change := range changes {
+		if change.Op == OpAdd {
+			result.Add(change.Element)
+		} else {
+			result.Remove(change.Element)
+		}
+	}
+	if !result.Equals(to) {
+		t.Errorf("applying changelog to %v gave %v, want %v", from, result, to)
+	}
+}
+
+func TestApplyChangelog(t *testing.T) {
+	s := NewHashSet(1, 2, 3)
+	ApplyChangelog[int](s, []Change[int]{
+		{Op: OpRemove, Element: 1},
+		{Op: OpRemove, Element: 9}, // absent: no-op
+		{Op: OpAdd, Element: 4},
+		{Op: OpAdd, Element: 2}, // present: no-op
+		{Op: OpRemove, Element: 1},
+	})
+	if want := NewHashSet(2, 3, 4); !s.Equals(want) {
+		t.Errorf("ApplyChangelog result = %v, want %v", s, want)
+	}
+}
+
+func TestApplyChangelogRoundTrip(t *testing.T) {
+	pairs := []struct {
+		from, to []int
+	}{
+		{[]int{1, 2, 3}, []int{2, 3, 4, 5}},
+		{[]int{1, 2}, []int{1, 2}},
+		{[]int{1, 2}, []int{3, 4}},
+		{nil, []int{1}},
+		{[]int{1}, nil},
+	}
+	for _, tt := range pairs {
+		from, to := NewHashSet(tt.from...), NewHashSet(tt.to...)
+		result := from.Clone()
+		ApplyChangelog(result, Changelog[int](from, to))
+		if !result.Equals(to) {
+			t.Errorf("ApplyChangelog(%v, Changelog(%v, %v)) = %v, want %v", from, from, to, result, to)
+		}
+	}
+}
+
+func TestOpString(t *testing.T) {
+	if OpAdd.String() != "Add" || OpRemove.String() != "Remove" {
+		t.Errorf("Op strings = %q, %q, want \"Add\", \"Remove\"", OpAdd, OpRemove)
+	}
+}
+
+func TestChangeDetector(t *testing.T) {
+	var detector ChangeDetector[int]
+	polls := []struct {
+		current        Set[int]
+		added, removed []int
+	}{
+		{NewHashSet(1, 2), []int{1, 2}, []int{}},
+		{NewHashSet(1, 2), []int{}, []int{}},
+		{NewHashSet(2, 3), []int{3}, []int{1}},
+		{NewHashSet[int](), []int{}, []int{2, 3}},
+		{NewHashSet(1), []int{1}, []int{}},
+	}
+	for i, poll := range polls {
+		added, removed := detector.Update(poll.current)
+		if got := sortedElements(added); !slices.Equal(got, poll.added) {
+			t.Errorf("poll %d: added = %v, want %v", i, got, poll.added)
+		}
+		if got := sortedElements(removed); !slices.Equal(got, poll.removed) {
+			t.Errorf("poll %d: removed = %v, want %v", i, got, poll.removed)
+		}
+	}
+
+	// Mutating the polled set after Update must not affect the stored snapshot.
+	current := NewHashSet(1)
+	detector.Update(current)
+	current.Add(5)
+	if added, _ := detector.Update(current); !added.Equals(NewHashSet(5)) {
+		t.Errorf("added after external mutation = %v, want {5}", added)
+	}
+}
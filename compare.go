@@ -0,0 +1,86 @@
+package goset
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// EqualsNoLen reports whether two sets contain identical elements without calling Len on either.
+// It streams both sides and checks that each is a subset of the other, which is preferable
+// when an implementation's Len is expensive to compute.
+//
+// Time complexity: O(n * c1 + m * c2) where n and m are the sizes of the sets
+// and c1, c2 are time complexities of b's and a's Contains() methods.
+func EqualsNoLen[T comparable](a, b Set[T]) bool {
+	for element := range a.All() {
+		if !b.Contains(element) {
+			return false
+		}
+	}
+	for element := range b.All() {
+		if !a.Contains(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// ApproxEqual reports whether two sets are close enough to be considered equal:
+// the fraction of their union lying in the symmetric difference, |A △ B| / |A ∪ B|,
+// must not exceed maxDiffRatio. This is equivalent to 1 - Jaccard(a, b) <= maxDiffRatio.
+// Two empty sets are always approximately equal.
+//
+// Time complexity: O(n * c) where n is size of a and c is time complexity of b's Contains() method.
+func ApproxEqual[T comparable](a, b Set[T], maxDiffRatio float64) bool {
+	return ChangeRatio(a, b) <= maxDiffRatio
+}
+
+// Bijectable reports whether a bijection exists between two sets, which for plain sets
+// holds exactly when they have the same cardinality.
+//
+// Time complexity: O(l) where l is time complexity of the sets' Len() method.
+func Bijectable[A, B comparable](a Set[A], b Set[B]) bool {
+	return a.Len() == b.Len()
+}
+
+// BijectionBy pairs the elements of two equally sized sets in sorted order:
+// the i-th smallest element of a maps to the i-th smallest element of b.
+// It returns false if the sets differ in size.
+//
+// Time complexity: O(n log n) where n is the size of the sets.
+func BijectionBy[A, B cmp.Ordered](a Set[A], b Set[B]) (map[A]B, bool) {
+	if !Bijectable(a, b) {
+		return nil, false
+	}
+	sortedB := slices.Sorted(b.All())
+	mapping := make(map[A]B, len(sortedB))
+	for i, element := range slices.Sorted(a.All()) {
+		mapping[element] = sortedB[i]
+	}
+	return mapping, true
+}
+
+// Explain returns a multi-line diagnostic summary of how two sets relate:
+// their sizes, the sizes of the intersection and both one-sided differences,
+// and the relation between them (equal, subset, superset, disjoint, or overlap).
+//
+// Time complexity: O(n * c) where n is size of a and c is time complexity of b's Contains() method.
+func Explain[T comparable](a, b Set[T]) string {
+	common := intersectionLen(a, b)
+	var relation string
+	switch {
+	case common == a.Len() && common == b.Len():
+		relation = "equal"
+	case common == a.Len():
+		relation = "subset"
+	case common == b.Len():
+		relation = "superset"
+	case common == 0:
+		relation = "disjoint"
+	default:
+		relation = "overlap"
+	}
+	return fmt.Sprintf("|A| = %d\n|B| = %d\n|A ∩ B| = %d\n|A \\ B| = %d\n|B \\ A| = %d\nrelation: %s",
+		a.Len(), b.Len(), common, a.Len()-common, b.Len()-common, relation)
+}
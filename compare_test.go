@@ -0,0 +1,111 @@
+package goset
+
+import (
+	"maps"
+	"strings"
+	"testing"
+)
+
+// expensiveLenSet is a Set whose Len is deliberately costly; it counts calls so tests can assert it is avoided.
+type expensiveLenSet struct {
+	Set[int]
+	lenCalls int
+}
+
+func (set *expensiveLenSet) Len() int {
+	set.lenCalls++
+	count := 0
+	for range set.Set.All() {
+		count++
+	}
+	return count
+}
+
+func TestEqualsNoLen(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Set[int]
+		want bool
+	}{
+		{name: "equal", a: NewHashSet(1, 2, 3), b: NewHashSet(3, 2, 1), want: true},
+		{name: "a has extra", a: NewHashSet(1, 2, 3), b: NewHashSet(1, 2), want: false},
+		{name: "b has extra", a: NewHashSet(1, 2), b: NewHashSet(1, 2, 3), want: false},
+		{name: "both empty", a: NewHashSet[int](), b: NewHashSet[int](), want: true},
+	}
+	for _, tt := range tests {
+		a := &expensiveLenSet{Set: tt.a}
+		b := &expensiveLenSet{Set: tt.b}
+		if got := EqualsNoLen[int](a, b); got != tt.want {
+			t.Errorf("%s: EqualsNoLen = %v, want %v", tt.name, got, tt.want)
+		}
+		if a.lenCalls != 0 || b.lenCalls != 0 {
+			t.Errorf("%s: Len called %d times, want 0", tt.name, a.lenCalls+b.lenCalls)
+		}
+	}
+}
+
+func TestExplain(t *testing.T) {
+	got := Explain[int](NewHashSet(1, 2, 3), NewHashSet(3, 4))
+	want := "|A| = 3\n|B| = 2\n|A ∩ B| = 1\n|A \\ B| = 2\n|B \\ A| = 1\nrelation: overlap"
+	if got != want {
+		t.Errorf("Explain = %q, want %q", got, want)
+	}
+
+	relations := []struct {
+		a, b Set[int]
+		want string
+	}{
+		{NewHashSet(1, 2), NewHashSet(2, 1), "equal"},
+		{NewHashSet(1), NewHashSet(1, 2), "subset"},
+		{NewHashSet(1, 2), NewHashSet(2), "superset"},
+		{NewHashSet(1), NewHashSet(2), "disjoint"},
+	}
+	for _, tt := range relations {
+		if got := Explain(tt.a, tt.b); !strings.HasSuffix(got, "relation: "+tt.want) {
+			t.Errorf("Explain(%v, %v) = %q, want relation %q", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestApproxEqual(t *testing.T) {
+	a := NewHashSet(1, 2, 3, 4)
+	b := NewHashSet(1, 2, 3, 5)
+	// |A △ B| = 2 and |A ∪ B| = 5, so the difference ratio is 0.4.
+	tests := []struct {
+		name         string
+		a, b         Set[int]
+		maxDiffRatio float64
+		want         bool
+	}{
+		{"identical", a, a.Clone(), 0, true},
+		{"both empty", NewHashSet[int](), NewHashSet[int](), 0, true},
+		{"under threshold", a, b, 0.41, true},
+		{"at threshold", a, b, 0.4, true},
+		{"over threshold", a, b, 0.39, false},
+	}
+	for _, tt := range tests {
+		if got := ApproxEqual(tt.a, tt.b, tt.maxDiffRatio); got != tt.want {
+			t.Errorf("%s: ApproxEqual = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestBijectable(t *testing.T) {
+	if !Bijectable[int, string](NewHashSet(1, 2, 3), NewHashSet("a", "b", "c")) {
+		t.Error("Bijectable of equal-size sets = false, want true")
+	}
+	if Bijectable[int, string](NewHashSet(1, 2), NewHashSet("a", "b", "c")) {
+		t.Error("Bijectable of unequal-size sets = true, want false")
+	}
+}
+
+func TestBijectionBy(t *testing.T) {
+	mapping, ok := BijectionBy[int, string](NewHashSet(30, 10, 20), NewHashSet("b", "c", "a"))
+	want := map[int]string{10: "a", 20: "b", 30: "c"}
+	if !ok || !maps.Equal(mapping, want) {
+		t.Errorf("BijectionBy = %v, %v, want %v, true", mapping, ok, want)
+	}
+	if mapping, ok := BijectionBy[int, string](NewHashSet(1), NewHashSet("a", "b")); ok || mapping != nil {
+		t.Errorf("BijectionBy of unequal-size sets = %v, %v, want nil, false", mapping, ok)
+	}
+}
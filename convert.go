@@ -0,0 +1,64 @@
+package goset
+
+import (
+	"slices"
+)
+
+// ToVector returns a membership vector over the universe: position i is true iff universe[i] is in the set.
+// Elements of the set that are not in the universe are ignored.
+//
+// Time complexity: O(u * c) where u is the length of the universe and c is time complexity of the set's Contains() method.
+func ToVector[T comparable](s Set[T], universe []T) []bool {
+	vector := make([]bool, len(universe))
+	for i, element := range universe {
+		vector[i] = s.Contains(element)
+	}
+	return vector
+}
+
+// FromVector returns a new set containing universe[i] for every position i where vector[i] is true.
+// It panics if the vector is longer than the universe.
+//
+// Time complexity: O(v) where v is the length of the vector.
+func FromVector[T comparable](vector []bool, universe []T) Set[T] {
+	newset := NewHashSet[T]()
+	for i, member := range vector {
+		if member {
+			newset.Add(universe[i])
+		}
+	}
+	return newset
+}
+
+// ToRanges returns the integers of the set as sorted, inclusive [start, end] ranges,
+// collapsing runs of consecutive integers: {1, 2, 3, 10, 11} becomes [[1, 3], [10, 11]].
+//
+// Time complexity: O(n log n) where n is the size of the set.
+func ToRanges(s Set[int]) [][2]int {
+	var ranges [][2]int
+	for _, element := range slices.Sorted(s.All()) {
+		if last := len(ranges) - 1; last >= 0 && ranges[last][1]+1 == element {
+			ranges[last][1] = element
+		} else {
+			ranges = append(ranges, [2]int{element, element})
+		}
+	}
+	return ranges
+}
+
+// FromRanges returns a new set containing every integer covered by the inclusive [start, end] ranges.
+// Ranges with start greater than end are empty.
+//
+// Time complexity: O(N) where N is the total number of integers covered.
+func FromRanges(ranges [][2]int) HashSet[int] {
+	set := make(HashSet[int])
+	for _, r := range ranges {
+		for element := r[0]; element <= r[1]; element++ {
+			set[element] = struct{}{}
+			if element == r[1] {
+				break // Avoid overflow when the range ends at math.MaxInt.
+			}
+		}
+	}
+	return set
+}
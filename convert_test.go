@@ -0,0 +1,48 @@
+package goset
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestToVectorFromVector(t *testing.T) {
+	universe := []string{"a", "b", "c", "d"}
+	// "z" is not in the universe and must be ignored.
+	s := NewHashSet("b", "d", "z")
+
+	vector := ToVector[string](s, universe)
+	if want := []bool{false, true, false, true}; !slices.Equal(vector, want) {
+		t.Errorf("ToVector = %v, want %v", vector, want)
+	}
+	if got, want := sortedElements(FromVector(vector, universe)), []string{"b", "d"}; !slices.Equal(got, want) {
+		t.Errorf("FromVector(ToVector) = %v, want %v", got, want)
+	}
+}
+
+func TestToRangesFromRanges(t *testing.T) {
+	tests := []struct {
+		name     string
+		elements []int
+		want     [][2]int
+	}{
+		{"runs", []int{1, 2, 3, 10, 11}, [][2]int{{1, 3}, {10, 11}}},
+		{"single-element runs", []int{7, 1, 4}, [][2]int{{1, 1}, {4, 4}, {7, 7}}},
+		{"runs and gaps", []int{-2, -1, 0, 2, 5, 6}, [][2]int{{-2, 0}, {2, 2}, {5, 6}}},
+		{"max int", []int{math.MaxInt - 1, math.MaxInt}, [][2]int{{math.MaxInt - 1, math.MaxInt}}},
+		{"empty", nil, nil},
+	}
+	for _, tt := range tests {
+		s := NewHashSet(tt.elements...)
+		ranges := ToRanges(s)
+		if !slices.Equal(ranges, tt.want) {
+			t.Errorf("%s: ToRanges = %v, want %v", tt.name, ranges, tt.want)
+		}
+		if back := FromRanges(ranges); !back.Equals(s) {
+			t.Errorf("%s: FromRanges(ToRanges) = %v, want %v", tt.name, back, s)
+		}
+	}
+	if got := FromRanges([][2]int{{3, 1}}); got.Len() != 0 {
+		t.Errorf("FromRanges with start > end = %v, want empty", got)
+	}
+}
@@ -0,0 +1,139 @@
+package goset
+
+import (
+	"slices"
+)
+
+// GreedyCover selects candidate sets that together cover the universe using the greedy
+// largest-uncovered heuristic: on each step it picks the candidate covering the most
+// still-uncovered elements. It returns the indices of the chosen candidates in selection order.
+//
+// The result is an approximation within a factor of ln(|universe|) of the optimal cover.
+// If the candidates cannot cover the universe, the partial cover is returned along with ErrIncompleteCover.
+//
+// Time complexity: O(k * N) where k is the number of chosen candidates and N is the total size of all candidates.
+func GreedyCover[T comparable](universe Set[T], candidates []Set[T]) ([]int, error) {
+	uncovered := make(HashSet[T], universe.Len())
+	for element := range universe.All() {
+		uncovered[element] = struct{}{}
+	}
+	var chosen []int
+	for !uncovered.IsEmpty() {
+		best, bestGain := -1, 0
+		for i, candidate := range candidates {
+			if gain := intersectionLen(candidate, &uncovered); gain > bestGain {
+				best, bestGain = i, gain
+			}
+		}
+		if best < 0 {
+			return chosen, ErrIncompleteCover
+		}
+		chosen = append(chosen, best)
+		uncovered.Subtract(candidates[best])
+	}
+	return chosen, nil
+}
+
+// MarginalCoverage returns how many universe elements the candidate would newly cover
+// given the already covered elements. It is the gain used to rank candidates in greedy selection loops.
+//
+// Time complexity: O(n * c) where n is size of the candidate and c is time complexity of the other sets' Contains() method.
+func MarginalCoverage[T comparable](covered Set[T], candidate Set[T], universe Set[T]) int {
+	gain := 0
+	for element := range candidate.All() {
+		if universe.Contains(element) && !covered.Contains(element) {
+			gain++
+		}
+	}
+	return gain
+}
+
+// GreedyHittingSet returns a small set of elements that intersects every given set, using the greedy
+// heuristic of repeatedly picking the element contained in the most sets not yet hit.
+// Empty sets cannot be hit and are ignored.
+//
+// Time complexity: O(k * N) where k is the size of the result and N is the total size of all sets.
+func GreedyHittingSet[T comparable](sets []Set[T]) Set[T] {
+	hitting := NewHashSet[T]()
+	unhit := make([]Set[T], 0, len(sets))
+	for _, set := range sets {
+		if !set.IsEmpty() {
+			unhit = append(unhit, set)
+		}
+	}
+	for len(unhit) > 0 {
+		var best T
+		bestCount := 0
+		for element, count := range countOccurrences(unhit) {
+			if count > bestCount {
+				best, bestCount = element, count
+			}
+		}
+		hitting.Add(best)
+		unhit = slices.DeleteFunc(unhit, func(set Set[T]) bool {
+			return set.Contains(best)
+		})
+	}
+	return hitting
+}
+
+// ExactCover finds a minimum-cardinality cover of the universe using at most maxSets candidates.
+// Unlike GreedyCover it returns an optimal solution, found by branch-and-bound with iterative
+// deepening: every branch picks an uncovered element and tries each candidate containing it.
+// It returns the indices of the chosen candidates and whether a cover within the bound exists.
+// A negative maxSets admits no cover.
+//
+// The search is exponential in maxSets and is intended for small instances.
+func ExactCover[T comparable](universe Set[T], candidates []Set[T], maxSets int) ([]int, bool) {
+	if maxSets < 0 {
+		return nil, false
+	}
+	uncovered := make(HashSet[T], universe.Len())
+	for element := range universe.All() {
+		uncovered[element] = struct{}{}
+	}
+	chosen := make([]int, 0, maxSets)
+	for limit := 0; limit <= maxSets; limit++ {
+		if searchCover(uncovered, candidates, limit, &chosen) {
+			return chosen, true
+		}
+	}
+	return nil, false
+}
+
+// searchCover tries to cover the uncovered elements with at most limit more candidates,
+// appending the chosen indices. The uncovered set is restored before returning.
+func searchCover[T comparable](uncovered HashSet[T], candidates []Set[T], limit int, chosen *[]int) bool {
+	if len(uncovered) == 0 {
+		return true
+	}
+	if limit == 0 {
+		return false
+	}
+	var pivot T
+	for pivot = range uncovered {
+		break
+	}
+	for i, candidate := range candidates {
+		if !candidate.Contains(pivot) {
+			continue
+		}
+		var covered []T
+		for element := range candidate.All() {
+			if uncovered.Contains(element) {
+				covered = append(covered, element)
+				delete(uncovered, element)
+			}
+		}
+		*chosen = append(*chosen, i)
+		found := searchCover(uncovered, candidates, limit-1, chosen)
+		for _, element := range covered {
+			uncovered[element] = struct{}{}
+		}
+		if found {
+			return true
+		}
+		*chosen = (*chosen)[:len(*chosen)-1]
+	}
+	return false
+}
@@ -0,0 +1,125 @@
+package goset
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestGreedyCover(t *testing.T) {
+	universe := NewHashSet(1, 2, 3, 4, 5, 6)
+	candidates := []Set[int]{
+		NewHashSet(1, 2),
+		NewHashSet(3, 4),
+		NewHashSet(5, 6),
+		NewHashSet(1, 2, 3, 4),
+		NewHashSet(4, 5, 6),
+	}
+	chosen, err := GreedyCover[int](universe, candidates)
+	if err != nil {
+		t.Fatalf("GreedyCover returned error: %v", err)
+	}
+	covered := NewHashSet[int]()
+	for _, i := range chosen {
+		covered.Merge(candidates[i])
+	}
+	if !covered.IsSuperset(universe) {
+		t.Errorf("chosen candidates %v cover %v, want a cover of %v", chosen, covered, universe)
+	}
+	if len(chosen) != 2 {
+		t.Errorf("GreedyCover chose %d candidates, want 2", len(chosen))
+	}
+}
+
+func TestGreedyCoverIncomplete(t *testing.T) {
+	universe := NewHashSet(1, 2, 3)
+	candidates := []Set[int]{NewHashSet(1), NewHashSet(2)}
+	chosen, err := GreedyCover[int](universe, candidates)
+	if !errors.Is(err, ErrIncompleteCover) {
+		t.Fatalf("GreedyCover error = %v, want ErrIncompleteCover", err)
+	}
+	if len(chosen) != 2 {
+		t.Errorf("partial cover = %v, want both candidates", chosen)
+	}
+}
+
+func TestExactCover(t *testing.T) {
+	// Greedy picks the largest candidate first and needs three sets; the optimum uses two.
+	universe := NewHashSet(1, 2, 3, 4, 5, 6)
+	candidates := []Set[int]{
+		NewHashSet(1, 2, 3, 4),
+		NewHashSet(1, 2, 5),
+		NewHashSet(3, 4, 6),
+	}
+	if greedy, _ := GreedyCover[int](universe, candidates); len(greedy) != 3 {
+		t.Fatalf("GreedyCover chose %v, want three candidates for this instance", greedy)
+	}
+
+	tests := []struct {
+		maxSets int
+		want    []int
+		ok      bool
+	}{
+		{maxSets: -1, want: nil, ok: false},
+		{maxSets: 0, want: nil, ok: false},
+		{maxSets: 1, want: nil, ok: false},
+		{maxSets: 2, want: []int{1, 2}, ok: true},
+		{maxSets: 3, want: []int{1, 2}, ok: true},
+	}
+	for _, tt := range tests {
+		chosen, ok := ExactCover[int](universe, candidates, tt.maxSets)
+		slices.Sort(chosen)
+		if ok != tt.ok || !slices.Equal(chosen, tt.want) {
+			t.Errorf("ExactCover(maxSets=%d) = %v, %v, want %v, %v", tt.maxSets, chosen, ok, tt.want, tt.ok)
+		}
+	}
+
+	if chosen, ok := ExactCover[int](NewHashSet[int](), candidates, 0); !ok || len(chosen) != 0 {
+		t.Errorf("ExactCover of empty universe = %v, %v, want no candidates and true", chosen, ok)
+	}
+}
+
+func TestMarginalCoverage(t *testing.T) {
+	universe := NewHashSet(1, 2, 3, 4, 5)
+	covered := NewHashSet(1, 2)
+	tests := []struct {
+		name      string
+		candidate Set[int]
+		want      int
+	}{
+		{"all new", NewHashSet(3, 4), 2},
+		{"partly covered", NewHashSet(2, 3), 1},
+		{"fully covered", NewHashSet(1, 2), 0},
+		{"outside universe", NewHashSet(5, 6, 7), 1},
+	}
+	for _, tt := range tests {
+		if got := MarginalCoverage[int](covered, tt.candidate, universe); got != tt.want {
+			t.Errorf("%s: MarginalCoverage = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestGreedyHittingSet(t *testing.T) {
+	tests := []struct {
+		name    string
+		sets    []Set[int]
+		wantLen int
+	}{
+		{"shared element", []Set[int]{NewHashSet(1, 9), NewHashSet(2, 9), NewHashSet(3, 9)}, 1},
+		{"chain", []Set[int]{NewHashSet(1, 2), NewHashSet(2, 3), NewHashSet(3, 4), NewHashSet(4, 5), NewHashSet(2, 4)}, 2},
+		{"disjoint", []Set[int]{NewHashSet(1), NewHashSet(2), NewHashSet(3)}, 3},
+		{"empty sets ignored", []Set[int]{NewHashSet[int](), NewHashSet(1)}, 1},
+		{"no sets", nil, 0},
+	}
+	for _, tt := range tests {
+		hitting := GreedyHittingSet(tt.sets)
+		for i, set := range tt.sets {
+			if set.Len() != 0 && set.Intersection(hitting).Len() == 0 {
+				t.Errorf("%s: GreedyHittingSet = %v misses set %d %v", tt.name, hitting, i, set)
+			}
+		}
+		if hitting.Len() != tt.wantLen {
+			t.Errorf("%s: GreedyHittingSet = %v, want %d elements", tt.name, hitting, tt.wantLen)
+		}
+	}
+}
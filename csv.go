@@ -0,0 +1,62 @@
+package goset
+
+import (
+	"cmp"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// ToCSVField returns the set as a single CSV line of its elements in ascending order.
+// Elements are formatted with %v and quoted according to encoding/csv rules,
+// so commas, quotes, and newlines inside elements are preserved.
+//
+// Time complexity: O(n log n) where n is the size of the set.
+func ToCSVField[T cmp.Ordered](s Set[T]) string {
+	sorted := slices.Sorted(s.All())
+	record := make([]string, len(sorted))
+	for i, element := range sorted {
+		record[i] = fmt.Sprint(element)
+	}
+	if len(record) == 1 && record[0] == "" {
+		// A lone empty field must be quoted to be distinguishable from an empty set.
+		return `""`
+	}
+	var builder strings.Builder
+	writer := csv.NewWriter(&builder)
+	// Writing to a strings.Builder cannot fail.
+	_ = writer.Write(record)
+	writer.Flush()
+	return strings.TrimSuffix(builder.String(), "\n")
+}
+
+// FromCSVField parses a CSV line produced by ToCSVField back into a set,
+// converting every field with the parse function.
+// An empty line yields an empty set. Input holding more than one CSV record
+// is rejected with ErrTrailingData rather than silently truncated.
+func FromCSVField[T comparable](field string, parse func(string) (T, error)) (HashSet[T], error) {
+	reader := csv.NewReader(strings.NewReader(field))
+	reader.FieldsPerRecord = -1
+	record, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return make(HashSet[T]), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, err := reader.Read(); !errors.Is(err, io.EOF) {
+		return nil, ErrTrailingData
+	}
+	set := make(HashSet[T], len(record))
+	for _, value := range record {
+		element, err := parse(value)
+		if err != nil {
+			return nil, err
+		}
+		set[element] = struct{}{}
+	}
+	return set, nil
+}
@@ -0,0 +1,60 @@
+package goset
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func identityParse(value string) (string, error) {
+	return value, nil
+}
+
+func TestCSVFieldRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		set  *HashSet[string]
+	}{
+		{name: "plain", set: NewHashSet("b", "a", "c")},
+		{name: "commas and quotes", set: NewHashSet("a,b", `say "hi"`, `"`, ",")},
+		{name: "newline", set: NewHashSet("line\nbreak")},
+		{name: "lone empty string", set: NewHashSet("")},
+		{name: "empty", set: NewHashSet[string]()},
+	}
+	for _, tt := range tests {
+		field := ToCSVField[string](tt.set)
+		got, err := FromCSVField(field, identityParse)
+		if err != nil {
+			t.Errorf("%s: FromCSVField(%q) error: %v", tt.name, field, err)
+			continue
+		}
+		if !got.Equal(*tt.set) {
+			t.Errorf("%s: round trip via %q = %v, want %v", tt.name, field, got, tt.set)
+		}
+	}
+}
+
+func TestToCSVFieldSorted(t *testing.T) {
+	if got, want := ToCSVField[int](NewHashSet(10, 2, 1)), "1,2,10"; got != want {
+		t.Errorf("ToCSVField = %q, want %q", got, want)
+	}
+}
+
+func TestFromCSVFieldParseError(t *testing.T) {
+	if _, err := FromCSVField("1,x", strconv.Atoi); err == nil {
+		t.Error("FromCSVField with an unparsable field returned no error")
+	}
+}
+
+func TestFromCSVFieldTrailingData(t *testing.T) {
+	if _, err := FromCSVField("1,2\n3,4", strconv.Atoi); !errors.Is(err, ErrTrailingData) {
+		t.Errorf("FromCSVField with two records error = %v, want ErrTrailingData", err)
+	}
+	got, err := FromCSVField("1,2\n", strconv.Atoi)
+	if err != nil {
+		t.Fatalf("FromCSVField with a trailing newline error: %v", err)
+	}
+	if want := NewHashSet(1, 2); !got.Equal(*want) {
+		t.Errorf("FromCSVField with a trailing newline = %v, want %v", got, want)
+	}
+}
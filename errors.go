@@ -0,0 +1,14 @@
+package goset
+
+import (
+	"errors"
+)
+
+// ErrIncompleteCover is returned when the candidate sets cannot cover the whole universe.
+var ErrIncompleteCover = errors.New("goset: candidates do not cover the universe")
+
+// ErrTrailingData is returned when input continues past the single record a parser expects.
+var ErrTrailingData = errors.New("goset: unexpected data after the first record")
+
+// ErrSetTooLarge is returned when a computed set would exceed the caller's size limit.
+var ErrSetTooLarge = errors.New("goset: set exceeds size limit")
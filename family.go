@@ -0,0 +1,217 @@
+package goset
+
+import (
+	"cmp"
+	"slices"
+)
+
+// ExactlyK returns a new set containing elements present in exactly k of the given sets.
+// Unlike an "at least k" query, elements shared by more than k sets are excluded.
+//
+// Time complexity: O(N) where N is the total number of elements across all sets.
+func ExactlyK[T comparable](k int, sets ...Set[T]) Set[T] {
+	newset := NewHashSet[T]()
+	for element, count := range countOccurrences(sets) {
+		if count == k {
+			newset.Add(element)
+		}
+	}
+	return newset
+}
+
+// ExactlyOnce returns a new set containing elements owned by exactly one of the given sets.
+// It is equivalent to ExactlyK(1, sets...). Unlike an odd-count XOR fold, an element
+// present in three sets is excluded.
+//
+// Time complexity: O(N) where N is the total number of elements across all sets.
+func ExactlyOnce[T comparable](sets ...Set[T]) Set[T] {
+	return ExactlyK(1, sets...)
+}
+
+// ParitySet returns a new set containing elements that appear in an odd number of the given sets,
+// i.e. the symmetric difference folded over all inputs. Unlike ExactlyOnce, an element
+// present in three sets is included.
+//
+// Time complexity: O(N) where N is the total number of elements across all sets.
+func ParitySet[T comparable](sets ...Set[T]) Set[T] {
+	total := 0
+	for _, set := range sets {
+		total += set.Len()
+	}
+	newset := make(HashSet[T], total)
+	for _, set := range sets {
+		for element := range set.All() {
+			if _, ok := newset[element]; ok {
+				delete(newset, element)
+			} else {
+				newset[element] = struct{}{}
+			}
+		}
+	}
+	return &newset
+}
+
+// FilterByFrequency returns a new set containing elements that appear in at least minCount of the given sets.
+// Occurrences are counted in a single pass over all sets.
+//
+// Time complexity: O(N) where N is the total number of elements across all sets.
+func FilterByFrequency[T comparable](minCount int, sets ...Set[T]) Set[T] {
+	newset := NewHashSet[T]()
+	for element, count := range countOccurrences(sets) {
+		if count >= minCount {
+			newset.Add(element)
+		}
+	}
+	return newset
+}
+
+// Majority returns a new set containing elements that appear in strictly more than half of the given sets,
+// i.e. whose count exceeds len(sets)/2 (integer division).
+//
+// Time complexity: O(N) where N is the total number of elements across all sets.
+func Majority[T comparable](sets ...Set[T]) Set[T] {
+	return FilterByFrequency(len(sets)/2+1, sets...)
+}
+
+// ByFrequency returns every distinct element with the number of given sets containing it,
+// sorted by descending count. The order of elements with equal counts is undefined.
+//
+// Time complexity: O(N + d log d) where N is the total number of elements across all sets and d is the number of distinct elements.
+func ByFrequency[T comparable](sets ...Set[T]) []Counted[T] {
+	counts := countOccurrences(sets)
+	result := make([]Counted[T], 0, len(counts))
+	for element, count := range counts {
+		result = append(result, Counted[T]{Element: element, Count: count})
+	}
+	slices.SortFunc(result, func(a, b Counted[T]) int {
+		return cmp.Compare(b.Count, a.Count)
+	})
+	return result
+}
+
+// PairwiseDisjoint reports whether no element appears in more than one of the given sets.
+// It stops at the first element found in two sets.
+//
+// Time complexity: O(N) where N is the total number of elements across all sets.
+func PairwiseDisjoint[T comparable](sets ...Set[T]) bool {
+	seen := make(map[T]struct{})
+	for _, set := range sets {
+		for element := range set.All() {
+			if _, ok := seen[element]; ok {
+				return false
+			}
+			seen[element] = struct{}{}
+		}
+	}
+	return true
+}
+
+// IsPartitionOf reports whether the parts are pairwise disjoint and their union equals the universe.
+//
+// Time complexity: O(N * c) where N is the total number of elements across all parts
+// and c is time complexity of the universe's Contains() method.
+func IsPartitionOf[T comparable](parts []Set[T], universe Set[T]) bool {
+	seen := make(map[T]struct{}, universe.Len())
+	for _, part := range parts {
+		for element := range part.All() {
+			if _, ok := seen[element]; ok || !universe.Contains(element) {
+				return false
+			}
+			seen[element] = struct{}{}
+		}
+	}
+	return len(seen) == universe.Len()
+}
+
+// FindCollisions returns a new set containing elements that appear in more than one of the given sets.
+// It is empty exactly when PairwiseDisjoint reports true.
+//
+// Time complexity: O(N) where N is the total number of elements across all sets.
+func FindCollisions[T comparable](sets ...Set[T]) Set[T] {
+	newset := NewHashSet[T]()
+	for element, count := range countOccurrences(sets) {
+		if count > 1 {
+			newset.Add(element)
+		}
+	}
+	return newset
+}
+
+// UniquePerLabel returns, for every label, a new set containing the elements of that labeled set
+// not present in any other labeled set.
+//
+// Time complexity: O(N) where N is the total number of elements across all sets.
+func UniquePerLabel[T comparable](named map[string]Set[T]) map[string]Set[T] {
+	counts := make(map[T]int)
+	for _, set := range named {
+		for element := range set.All() {
+			counts[element]++
+		}
+	}
+	unique := make(map[string]Set[T], len(named))
+	for label, set := range named {
+		newset := NewHashSet[T]()
+		for element := range set.All() {
+			if counts[element] == 1 {
+				newset.Add(element)
+			}
+		}
+		unique[label] = newset
+	}
+	return unique
+}
+
+// CoOccurrence counts, for every pair of distinct elements, the number of baskets containing both.
+// Pairs are keyed canonically with First < Second, so each unordered pair is counted once.
+//
+// Time complexity: O(B * s²) where B is the number of baskets and s is the size of the largest basket.
+func CoOccurrence[T cmp.Ordered](baskets []Set[T]) map[Tuple2[T, T]]int {
+	counts := make(map[Tuple2[T, T]]int)
+	for _, basket := range baskets {
+		elements := slices.Sorted(basket.All())
+		for i, first := range elements {
+			for _, second := range elements[i+1:] {
+				counts[T2(first, second)]++
+			}
+		}
+	}
+	return counts
+}
+
+// ContainmentPairs returns the index pairs (i, j) for which sets[i] is a proper subset of sets[j],
+// ordered by i and then j. Sets are first sorted by size so that only strictly larger sets
+// are tested as supersets, which also rules out self-pairs and pairs of equal sets.
+//
+// Time complexity: O(k² * s) where k is the number of sets and s is the size of the largest set.
+func ContainmentPairs[T comparable](sets []Set[T]) [][2]int {
+	order := make([]int, len(sets))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortFunc(order, func(a, b int) int {
+		return cmp.Compare(sets[a].Len(), sets[b].Len())
+	})
+	var pairs [][2]int
+	for x, i := range order {
+		for _, j := range order[x+1:] {
+			if sets[i].Len() < sets[j].Len() && sets[i].IsSubset(sets[j]) {
+				pairs = append(pairs, [2]int{i, j})
+			}
+		}
+	}
+	slices.SortFunc(pairs, func(a, b [2]int) int {
+		return cmp.Or(cmp.Compare(a[0], b[0]), cmp.Compare(a[1], b[1]))
+	})
+	return pairs
+}
+
+// countOccurrences returns, for every distinct element, the number of sets containing it.
+func countOccurrences[T comparable](sets []Set[T]) map[T]int {
+	counts := make(map[T]int)
+	for _, set := range sets {
+		for element := range set.All() {
+			counts[element]++
+		}
+	}
+	return counts
+}
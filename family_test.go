@@ -0,0 +1,247 @@
+package goset
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"testing"
+)
+
+// sortedElements returns the set's elements in ascending order for deterministic comparison.
+func sortedElements[T cmp.Ordered](s Set[T]) []T {
+	return slices.Sorted(s.All())
+}
+
+func TestExactlyK(t *testing.T) {
+	a := NewHashSet(1, 2, 3)
+	b := NewHashSet(2, 3)
+	c := NewHashSet(3, 4)
+
+	// 1 and 4 appear once, 2 appears twice, 3 appears three times.
+	tests := []struct {
+		k    int
+		want []int
+	}{
+		{k: 1, want: []int{1, 4}},
+		{k: 2, want: []int{2}},
+		{k: 3, want: []int{3}},
+		{k: 4, want: []int{}},
+	}
+	for _, tt := range tests {
+		got := sortedElements(ExactlyK(tt.k, Set[int](a), b, c))
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("ExactlyK(%d) = %v, want %v", tt.k, got, tt.want)
+		}
+	}
+}
+
+func TestPairwiseDisjoint(t *testing.T) {
+	tests := []struct {
+		name string
+		sets []Set[int]
+		want bool
+	}{
+		{"no sets", nil, true},
+		{"clean partition", []Set[int]{NewHashSet(1, 2), NewHashSet(3), NewHashSet(4, 5)}, true},
+		{"one shared element", []Set[int]{NewHashSet(1, 2), NewHashSet(3), NewHashSet(2, 4)}, false},
+	}
+	for _, tt := range tests {
+		if got := PairwiseDisjoint(tt.sets...); got != tt.want {
+			t.Errorf("%s: PairwiseDisjoint = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFindCollisions(t *testing.T) {
+	a := NewHashSet(1, 2, 3)
+	b := NewHashSet(3, 4)
+	c := NewHashSet(1, 5)
+	d := NewHashSet(4, 6)
+
+	// 1 collides between a and c, 3 between a and b, 4 between b and d.
+	got := sortedElements(FindCollisions(Set[int](a), b, c, d))
+	if want := []int{1, 3, 4}; !slices.Equal(got, want) {
+		t.Errorf("FindCollisions = %v, want %v", got, want)
+	}
+	if got := FindCollisions(Set[int](a), NewHashSet(7)); got.Len() != 0 {
+		t.Errorf("FindCollisions of disjoint sets = %v, want empty", got)
+	}
+}
+
+func TestFilterByFrequency(t *testing.T) {
+	sets := []Set[int]{
+		NewHashSet(1, 2, 3, 4),
+		NewHashSet(2, 3, 4),
+		NewHashSet(3, 4),
+		NewHashSet(4, 5),
+	}
+	tests := []struct {
+		minCount int
+		want     []int
+	}{
+		{minCount: 0, want: []int{1, 2, 3, 4, 5}},
+		{minCount: 1, want: []int{1, 2, 3, 4, 5}},
+		{minCount: 2, want: []int{2, 3, 4}},
+		{minCount: 3, want: []int{3, 4}},
+		{minCount: 4, want: []int{4}},
+		{minCount: 5, want: []int{}},
+	}
+	for _, tt := range tests {
+		got := sortedElements(FilterByFrequency(tt.minCount, sets...))
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("FilterByFrequency(%d) = %v, want %v", tt.minCount, got, tt.want)
+		}
+	}
+}
+
+func TestExactlyOnce(t *testing.T) {
+	// 1 is in one set, 2 in two sets and 3 in all three.
+	a := NewHashSet(1, 2, 3)
+	b := NewHashSet(2, 3)
+	c := NewHashSet(3)
+
+	if got, want := sortedElements(ExactlyOnce(Set[int](a), b, c)), []int{1}; !slices.Equal(got, want) {
+		t.Errorf("ExactlyOnce = %v, want %v", got, want)
+	}
+	// An odd-count XOR fold keeps 3 as well.
+	if got, want := sortedElements(a.SymmetricDifference(b).SymmetricDifference(c)), []int{1, 3}; !slices.Equal(got, want) {
+		t.Errorf("XOR fold = %v, want %v", got, want)
+	}
+}
+
+func TestUniquePerLabel(t *testing.T) {
+	named := map[string]Set[string]{
+		"alice": NewHashSet("go", "rust", "sql"),
+		"bob":   NewHashSet("go", "java"),
+		"carol": NewHashSet("sql", "java", "zig"),
+	}
+	unique := UniquePerLabel(named)
+	want := map[string][]string{
+		"alice": {"rust"},
+		"bob":   nil,
+		"carol": {"zig"},
+	}
+	if len(unique) != len(want) {
+		t.Fatalf("UniquePerLabel returned %d labels, want %d", len(unique), len(want))
+	}
+	for label, elements := range want {
+		if got := sortedElements(unique[label]); !slices.Equal(got, elements) {
+			t.Errorf("UniquePerLabel[%q] = %v, want %v", label, got, elements)
+		}
+	}
+}
+
+func TestIsPartitionOf(t *testing.T) {
+	universe := NewHashSet(1, 2, 3, 4, 5)
+	tests := []struct {
+		name  string
+		parts []Set[int]
+		want  bool
+	}{
+		{"valid", []Set[int]{NewHashSet(1, 2), NewHashSet(3), NewHashSet(4, 5)}, true},
+		{"overlap", []Set[int]{NewHashSet(1, 2, 3), NewHashSet(3, 4, 5)}, false},
+		{"missing coverage", []Set[int]{NewHashSet(1, 2), NewHashSet(4, 5)}, false},
+		{"outside universe", []Set[int]{NewHashSet(1, 2, 3), NewHashSet(4, 5, 6)}, false},
+	}
+	for _, tt := range tests {
+		if got := IsPartitionOf[int](tt.parts, universe); got != tt.want {
+			t.Errorf("%s: IsPartitionOf = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCoOccurrence(t *testing.T) {
+	baskets := []Set[string]{
+		NewHashSet("bread", "milk"),
+		NewHashSet("milk", "bread", "eggs"),
+		NewHashSet("eggs", "milk"),
+		NewHashSet("tea"),
+	}
+	want := map[Tuple2[string, string]]int{
+		T2("bread", "milk"): 2,
+		T2("bread", "eggs"): 1,
+		T2("eggs", "milk"):  2,
+	}
+	if got := CoOccurrence(baskets); !maps.Equal(got, want) {
+		t.Errorf("CoOccurrence = %v, want %v", got, want)
+	}
+}
+
+func TestContainmentPairs(t *testing.T) {
+	sets := []Set[int]{
+		NewHashSet(1, 2, 3), // 0
+		NewHashSet(1),       // 1
+		NewHashSet(1, 2),    // 2
+		NewHashSet(4),       // 3
+		NewHashSet(2, 1),    // 4, equal to 2
+	}
+	got := ContainmentPairs(sets)
+	want := [][2]int{{1, 0}, {1, 2}, {1, 4}, {2, 0}, {4, 0}}
+	if !slices.Equal(got, want) {
+		t.Errorf("ContainmentPairs = %v, want %v", got, want)
+	}
+}
+
+func TestMajority(t *testing.T) {
+	tests := []struct {
+		name string
+		sets []Set[int]
+		want []int
+	}{
+		{
+			// Three sets: majority means at least two.
+			name: "odd",
+			sets: []Set[int]{NewHashSet(1, 2, 3), NewHashSet(2, 3), NewHashSet(3, 4)},
+			want: []int{2, 3},
+		},
+		{
+			// Four sets: exactly half is not a majority, three are needed.
+			name: "even",
+			sets: []Set[int]{NewHashSet(1, 2, 3), NewHashSet(2, 3), NewHashSet(3, 4), NewHashSet(1, 3)},
+			want: []int{3},
+		},
+		{name: "no sets", sets: nil, want: []int{}},
+	}
+	for _, tt := range tests {
+		if got := sortedElements(Majority(tt.sets...)); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: Majority = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestByFrequency(t *testing.T) {
+	counted := ByFrequency(Set[string](NewHashSet("a", "b", "c")), NewHashSet("b", "c"), NewHashSet("c", "d"))
+	counts := make(map[string]int)
+	for i, c := range counted {
+		counts[c.Element] = c.Count
+		if i > 0 && counted[i-1].Count < c.Count {
+			t.Errorf("ByFrequency not descending at %d: %v", i, counted)
+		}
+	}
+	want := map[string]int{"a": 1, "b": 2, "c": 3, "d": 1}
+	if len(counted) != len(want) || !maps.Equal(counts, want) {
+		t.Errorf("ByFrequency = %v, want counts %v", counted, want)
+	}
+	if counted[0] != (Counted[string]{Element: "c", Count: 3}) {
+		t.Errorf("ByFrequency()[0] = %v, want {c 3}", counted[0])
+	}
+}
+
+func TestParitySet(t *testing.T) {
+	// 1 appears in one set, 2 in two, 3 in three and 4 in four.
+	sets := []Set[int]{
+		NewHashSet(1, 2, 3, 4),
+		NewHashSet(2, 3, 4),
+		NewHashSet(3, 4),
+		NewHashSet(4),
+	}
+	if got, want := sortedElements(ParitySet(sets...)), []int{1, 3}; !slices.Equal(got, want) {
+		t.Errorf("ParitySet = %v, want %v", got, want)
+	}
+	if got, want := sortedElements(ExactlyOnce(sets...)), []int{1}; !slices.Equal(got, want) {
+		t.Errorf("ExactlyOnce = %v, want %v", got, want)
+	}
+	if got := ParitySet[int](); got.Len() != 0 {
+		t.Errorf("ParitySet() = %v, want empty", got)
+	}
+}
@@ -0,0 +1,54 @@
+package goset
+
+// FloatSet is a set of floating-point numbers that ignores NaN values.
+//
+// NaN never equals itself, so a plain map-based set stores every NaN as a separate element
+// that Contains can never find, and Len grows with each insertion. FloatSet drops NaN on
+// insertion instead, so NaN is never a member and Len and Contains behave predictably.
+// Note that -0.0 and +0.0 compare equal and are treated as the same element.
+//
+// The zero value is not usable - use NewFloatSet to create instances.
+type FloatSet[F ~float32 | ~float64] struct {
+	Set[F]
+}
+
+// NewFloatSet creates a new HashSet-backed FloatSet with optional initial elements. NaN elements are dropped.
+func NewFloatSet[F ~float32 | ~float64](elements ...F) *FloatSet[F] {
+	set := &FloatSet[F]{Set: NewHashSet[F]()}
+	for _, element := range elements {
+		set.Add(element)
+	}
+	return set
+}
+
+// Add inserts the element into the set. NaN is ignored.
+func (set *FloatSet[F]) Add(element F) {
+	if isNaN(element) {
+		return
+	}
+	set.Set.Add(element)
+}
+
+// Merge adds all non-NaN elements from the other set to this set (in-place union).
+func (set *FloatSet[F]) Merge(other Set[F]) {
+	for element := range other.All() {
+		set.Add(element)
+	}
+}
+
+// Xor replaces this set with elements present in exactly one set (in-place symmetric difference).
+// NaN elements of the other set are ignored.
+func (set *FloatSet[F]) Xor(other Set[F]) {
+	for element := range other.All() {
+		if set.Set.Contains(element) {
+			set.Set.Remove(element)
+		} else {
+			set.Add(element)
+		}
+	}
+}
+
+// isNaN reports whether f is a NaN value.
+func isNaN[F ~float32 | ~float64](f F) bool {
+	return f != f
+}
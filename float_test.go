@@ -0,0 +1,32 @@
+package goset
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloatSetNaN(t *testing.T) {
+	nan := math.NaN()
+	s := NewFloatSet(1.5, nan, 2.5, nan)
+	s.Add(nan)
+	s.Add(math.Copysign(nan, -1))
+	if got := s.Len(); got != 2 {
+		t.Errorf("Len after adding NaNs = %d, want 2", got)
+	}
+	if s.Contains(nan) {
+		t.Error("Contains(NaN) = true, want false")
+	}
+
+	s.Merge(NewHashSet(nan, 3.5))
+	s.Xor(NewHashSet(nan, 1.5))
+	if want := NewHashSet(2.5, 3.5); !s.Equals(want) {
+		t.Errorf("after Merge and Xor with NaN: %v, want %v", s, want)
+	}
+}
+
+func TestFloatSetSignedZero(t *testing.T) {
+	s := NewFloatSet(0.0, math.Copysign(0, -1))
+	if got := s.Len(); got != 1 {
+		t.Errorf("Len with +0 and -0 = %d, want 1", got)
+	}
+}
@@ -2,4 +2,6 @@ module goset
 
 go 1.23.1
 
+require github.com/google/go-cmp v0.7.0
+
 require pgregory.net/rapid v1.2.0 // indirect
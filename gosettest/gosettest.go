@@ -0,0 +1,19 @@
+// Package gosettest provides utilities for testing code that uses goset sets.
+package gosettest
+
+import (
+	"testing"
+
+	"goset"
+)
+
+// AssertUnmodified snapshots the set, runs f, and fails the test if the set's contents changed.
+// It is useful to prove that a function does not mutate its input.
+func AssertUnmodified[T comparable](t testing.TB, s goset.Set[T], f func()) {
+	t.Helper()
+	snapshot := s.Clone()
+	f()
+	if !s.Equals(snapshot) {
+		t.Errorf("set was modified: before %v, after %v", snapshot, s)
+	}
+}
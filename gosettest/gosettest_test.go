@@ -0,0 +1,56 @@
+package gosettest
+
+import (
+	"testing"
+
+	"goset"
+)
+
+// recordingTB captures failures instead of reporting them to the enclosing test.
+type recordingTB struct {
+	testing.TB
+	failed bool
+}
+
+func (tb *recordingTB) Helper() {}
+func (tb *recordingTB) Errorf(format string, args ...any) {
+	tb.failed = true
+}
+
+func TestAssertUnmodifiedOperations(t *testing.T) {
+	tests := []struct {
+		name string
+		op   func(a, b goset.Set[int]) goset.Set[int]
+	}{
+		{"Union", func(a, b goset.Set[int]) goset.Set[int] { return a.Union(b) }},
+		{"Intersection", func(a, b goset.Set[int]) goset.Set[int] { return a.Intersection(b) }},
+		{"Difference", func(a, b goset.Set[int]) goset.Set[int] { return a.Difference(b) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := goset.NewHashSet(1, 2, 3), goset.NewHashSet(3, 4)
+			AssertUnmodified[int](t, a, func() {
+				AssertUnmodified[int](t, b, func() {
+					tt.op(a, b)
+				})
+			})
+		})
+	}
+}
+
+func TestAssertUnmodifiedDetectsMutation(t *testing.T) {
+	s := goset.NewHashSet(1, 2)
+	tb := &recordingTB{TB: t}
+	AssertUnmodified[int](tb, s, func() {
+		s.Add(3)
+	})
+	if !tb.failed {
+		t.Error("AssertUnmodified did not fail after the set was modified")
+	}
+
+	tb = &recordingTB{TB: t}
+	AssertUnmodified[int](tb, s, func() {})
+	if tb.failed {
+		t.Error("AssertUnmodified failed although the set was not modified")
+	}
+}
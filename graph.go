@@ -0,0 +1,171 @@
+package goset
+
+import (
+	"maps"
+	"math"
+)
+
+// Closure returns the smallest set containing the seed that is closed under the neighbor function,
+// i.e. every element reachable from the seed by repeatedly applying neighbors.
+// The expansion is a breadth-first search over a worklist and terminates on cyclic relations.
+//
+// Time complexity: O(V + E) where V is the size of the closure and E is the total number of neighbors visited.
+func Closure[T comparable](seed Set[T], neighbors func(T) []T) Set[T] {
+	closure, _ := ClosureLimited(seed, neighbors, math.MaxInt)
+	return closure
+}
+
+// ClosureLimited is like Closure but gives up once the closure grows beyond maxSize elements,
+// returning ErrSetTooLarge. It guards against runaway expansion by explosive neighbor functions.
+//
+// Time complexity: O(V + E) where V is the size of the closure and E is the total number of neighbors visited.
+func ClosureLimited[T comparable](seed Set[T], neighbors func(T) []T, maxSize int) (Set[T], error) {
+	if seed.Len() > maxSize {
+		return nil, ErrSetTooLarge
+	}
+	closure := make(HashSet[T], seed.Len())
+	worklist := make([]T, 0, seed.Len())
+	for element := range seed.All() {
+		closure[element] = struct{}{}
+		worklist = append(worklist, element)
+	}
+	for len(worklist) > 0 {
+		element := worklist[0]
+		worklist = worklist[1:]
+		for _, neighbor := range neighbors(element) {
+			if closure.Contains(neighbor) {
+				continue
+			}
+			if closure.Len() == maxSize {
+				return nil, ErrSetTooLarge
+			}
+			closure[neighbor] = struct{}{}
+			worklist = append(worklist, neighbor)
+		}
+	}
+	return &closure, nil
+}
+
+// Boundary returns a new set containing the elements of s that have at least one neighbor outside s.
+//
+// Time complexity: O(n + E * c) where n is the size of the set, E is the total number of neighbors visited
+// and c is time complexity of the set's Contains() method.
+func Boundary[T comparable](s Set[T], neighbors func(T) []T) Set[T] {
+	return partitionByNeighbors(s, neighbors, true)
+}
+
+// Interior returns a new set containing the elements of s whose neighbors all belong to s.
+// It is the complement of Boundary within s.
+//
+// Time complexity: O(n + E * c) where n is the size of the set, E is the total number of neighbors visited
+// and c is time complexity of the set's Contains() method.
+func Interior[T comparable](s Set[T], neighbors func(T) []T) Set[T] {
+	return partitionByNeighbors(s, neighbors, false)
+}
+
+// partitionByNeighbors returns the elements of s that have (boundary) or lack (interior) a neighbor outside s.
+func partitionByNeighbors[T comparable](s Set[T], neighbors func(T) []T, boundary bool) Set[T] {
+	newset := NewHashSet[T]()
+	for element := range s.All() {
+		outside := false
+		for _, neighbor := range neighbors(element) {
+			if !s.Contains(neighbor) {
+				outside = true
+				break
+			}
+		}
+		if outside == boundary {
+			newset.Add(element)
+		}
+	}
+	return newset
+}
+
+// TransitiveReduction returns the direct edges of a DAG given each node's reachable set:
+// an edge u → v is kept only if v is not reachable from u through some other node w.
+// The input is expected to be transitively closed, as produced by Closure, and is not mutated.
+//
+// Time complexity: O(V * R² * c) where V is the number of nodes, R is the size of the largest reachable set
+// and c is time complexity of the reachable sets' Contains() method.
+func TransitiveReduction[T comparable](reachable map[T]Set[T]) map[T]Set[T] {
+	reduced := make(map[T]Set[T], len(reachable))
+	for node, targets := range reachable {
+		direct := NewHashSet[T]()
+		for target := range targets.All() {
+			if target == node {
+				continue
+			}
+			implied := false
+			for via := range targets.All() {
+				if via == node || via == target {
+					continue
+				}
+				if through, ok := reachable[via]; ok && through.Contains(target) {
+					implied = true
+					break
+				}
+			}
+			if !implied {
+				direct.Add(target)
+			}
+		}
+		reduced[node] = direct
+	}
+	return reduced
+}
+
+// MaximalCompatibleSets enumerates every maximal subset of s in which all pairs of elements are compatible,
+// i.e. the maximal cliques of the compatibility graph, using Bron–Kerbosch with pivoting.
+// The compatible predicate must be symmetric. A lone element with no compatible partner forms its own set.
+//
+// The number of maximal sets can grow as 3^(n/3) for n elements, so the worst case is exponential;
+// callers should bound the size of s before enumerating.
+func MaximalCompatibleSets[T comparable](s Set[T], compatible func(a, b T) bool) []Set[T] {
+	elements := s.Elements()
+	adjacent := make([]HashSet[int], len(elements))
+	for i := range elements {
+		adjacent[i] = make(HashSet[int])
+	}
+	for i := range elements {
+		for j := i + 1; j < len(elements); j++ {
+			if compatible(elements[i], elements[j]) {
+				adjacent[i][j] = struct{}{}
+				adjacent[j][i] = struct{}{}
+			}
+		}
+	}
+	candidates := make(HashSet[int], len(elements))
+	for i := range elements {
+		candidates[i] = struct{}{}
+	}
+	var result []Set[T]
+	var expand func(clique []int, candidates, excluded HashSet[int])
+	expand = func(clique []int, candidates, excluded HashSet[int]) {
+		if len(candidates) == 0 && len(excluded) == 0 {
+			newset := make(HashSet[T], len(clique))
+			for _, i := range clique {
+				newset[elements[i]] = struct{}{}
+			}
+			result = append(result, &newset)
+			return
+		}
+		pivot, pivotDegree := -1, -1
+		for _, group := range []HashSet[int]{candidates, excluded} {
+			for u := range group {
+				if degree := intersectionLen[int](&candidates, &adjacent[u]); degree > pivotDegree {
+					pivot, pivotDegree = u, degree
+				}
+			}
+		}
+		for v := range maps.Clone(candidates) {
+			if adjacent[pivot].Contains(v) {
+				continue
+			}
+			expand(append(clique, v), IntersectionHashSet(candidates, adjacent[v]), IntersectionHashSet(excluded, adjacent[v]))
+			delete(candidates, v)
+			excluded[v] = struct{}{}
+		}
+	}
+	expand(nil, candidates, make(HashSet[int]))
+	return result
+}
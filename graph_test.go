@@ -0,0 +1,135 @@
+package goset
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// adjacency returns a neighbor function over a fixed directed graph.
+func adjacency(edges map[string][]string) func(string) []string {
+	return func(node string) []string {
+		return edges[node]
+	}
+}
+
+func TestClosure(t *testing.T) {
+	neighbors := adjacency(map[string][]string{
+		"a": {"b", "c"},
+		"b": {"d"},
+		"c": {"a"},
+		"e": {"f"},
+	})
+	got := sortedElements(Closure[string](NewHashSet("a"), neighbors))
+	if want := []string{"a", "b", "c", "d"}; !slices.Equal(got, want) {
+		t.Errorf("Closure = %v, want %v", got, want)
+	}
+}
+
+func TestBoundaryAndInterior(t *testing.T) {
+	// A 3x3 block of cells on an unbounded grid, encoded as row*10+col.
+	cells := NewHashSet[int]()
+	for row := range 3 {
+		for col := range 3 {
+			cells.Add(row*10 + col)
+		}
+	}
+	neighbors := func(cell int) []int {
+		return []int{cell - 10, cell + 10, cell - 1, cell + 1}
+	}
+
+	if got, want := sortedElements(Interior[int](cells, neighbors)), []int{11}; !slices.Equal(got, want) {
+		t.Errorf("Interior = %v, want %v", got, want)
+	}
+	want := []int{0, 1, 2, 10, 12, 20, 21, 22}
+	if got := sortedElements(Boundary[int](cells, neighbors)); !slices.Equal(got, want) {
+		t.Errorf("Boundary = %v, want %v", got, want)
+	}
+}
+
+func TestClosureLimited(t *testing.T) {
+	cycle := adjacency(map[string][]string{
+		"a": {"b"},
+		"b": {"c"},
+		"c": {"a"},
+	})
+	closure, err := ClosureLimited[string](NewHashSet("a"), cycle, 3)
+	if err != nil {
+		t.Fatalf("ClosureLimited on a cycle returned error: %v", err)
+	}
+	if got, want := sortedElements(closure), []string{"a", "b", "c"}; !slices.Equal(got, want) {
+		t.Errorf("ClosureLimited = %v, want %v", got, want)
+	}
+
+	// Every number leads to two larger ones, so the closure is infinite.
+	explosive := func(n int) []int { return []int{2 * n, 2*n + 1} }
+	if _, err := ClosureLimited[int](NewHashSet(1), explosive, 100); !errors.Is(err, ErrSetTooLarge) {
+		t.Errorf("ClosureLimited on explosive neighbors error = %v, want ErrSetTooLarge", err)
+	}
+	if _, err := ClosureLimited[int](NewHashSet(1, 2), explosive, 1); !errors.Is(err, ErrSetTooLarge) {
+		t.Errorf("ClosureLimited with oversized seed error = %v, want ErrSetTooLarge", err)
+	}
+}
+
+func TestTransitiveReduction(t *testing.T) {
+	// Direct edges a→b, b→c and c→d; a→c, a→d and b→d are implied transitively.
+	reachable := map[string]Set[string]{
+		"a": NewHashSet("b", "c", "d"),
+		"b": NewHashSet("c", "d"),
+		"c": NewHashSet("d"),
+		"d": NewHashSet[string](),
+	}
+	reduced := TransitiveReduction(reachable)
+	want := map[string][]string{
+		"a": {"b"},
+		"b": {"c"},
+		"c": {"d"},
+		"d": nil,
+	}
+	for node, edges := range want {
+		if got := sortedElements(reduced[node]); !slices.Equal(got, edges) {
+			t.Errorf("TransitiveReduction[%q] = %v, want %v", node, got, edges)
+		}
+	}
+	if reachable["a"].Len() != 3 {
+		t.Errorf("TransitiveReduction modified its input: %v", reachable["a"])
+	}
+}
+
+func TestMaximalCompatibleSets(t *testing.T) {
+	tests := []struct {
+		name       string
+		elements   []int
+		compatible func(a, b int) bool
+		want       [][]int
+	}{
+		{
+			name:       "same parity",
+			elements:   []int{1, 2, 3, 4},
+			compatible: func(a, b int) bool { return a%2 == b%2 },
+			want:       [][]int{{1, 3}, {2, 4}},
+		},
+		{
+			name:       "adjacent numbers",
+			elements:   []int{1, 2, 3, 4},
+			compatible: func(a, b int) bool { return a-b == 1 || b-a == 1 },
+			want:       [][]int{{1, 2}, {2, 3}, {3, 4}},
+		},
+		{
+			name:       "lone element",
+			elements:   []int{1, 2, 3, 10},
+			compatible: func(a, b int) bool { return a < 10 && b < 10 },
+			want:       [][]int{{1, 2, 3}, {10}},
+		},
+	}
+	for _, tt := range tests {
+		var got [][]int
+		for _, set := range MaximalCompatibleSets[int](NewHashSet(tt.elements...), tt.compatible) {
+			got = append(got, sortedElements(set))
+		}
+		slices.SortFunc(got, slices.Compare)
+		if !slices.EqualFunc(got, tt.want, slices.Equal) {
+			t.Errorf("%s: MaximalCompatibleSets = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
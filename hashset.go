@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"iter"
 	"maps"
+	"math/rand/v2"
+	"slices"
 	"strings"
 )
 
@@ -26,6 +28,20 @@ func NewHashSet[T comparable](elements ...T) *HashSet[T] {
 	return &set
 }
 
+// CountDistinct returns the set of distinct items together with the number of occurrences of each,
+// computed in a single pass over the slice.
+//
+// Time complexity: O(n) where n is the length of the slice.
+func CountDistinct[T comparable](items []T) (HashSet[T], map[T]int) {
+	set := make(HashSet[T])
+	counts := make(map[T]int)
+	for _, item := range items {
+		set[item] = struct{}{}
+		counts[item]++
+	}
+	return set, counts
+}
+
 // Add inserts an element into the set.
 // If the element already exists, it's a no-op.
 //
@@ -50,6 +66,40 @@ func (set HashSet[T]) Contains(element T) bool {
 	return ok
 }
 
+// CanAdd reports whether adding the element keeps the set within maxSize elements.
+// It returns true if the element is already present or if the set has room to grow.
+//
+// Time complexity: O(1).
+func (set HashSet[T]) CanAdd(element T, maxSize int) bool {
+	return set.Contains(element) || set.Len() < maxSize
+}
+
+// WouldChange reports whether adding the elements would change the set,
+// i.e. whether any of them is not already present.
+//
+// Time complexity: O(k) where k is the number of elements.
+func (set HashSet[T]) WouldChange(elements ...T) bool {
+	for _, element := range elements {
+		if !set.Contains(element) {
+			return true
+		}
+	}
+	return false
+}
+
+// WouldChangeOnRemove reports whether removing the elements would change the set,
+// i.e. whether any of them is present.
+//
+// Time complexity: O(k) where k is the number of elements.
+func (set HashSet[T]) WouldChangeOnRemove(elements ...T) bool {
+	for _, element := range elements {
+		if set.Contains(element) {
+			return true
+		}
+	}
+	return false
+}
+
 // Union returns a new set containing all elements present in either set.
 //
 // Time complexity: O(n + m) where n and m are the sizes of the sets.
@@ -65,12 +115,31 @@ func (set HashSet[T]) Union(other Set[T]) Set[T] {
 }
 
 // Intersection returns a new set containing elements present in both sets.
+// The result is pre-sized to the smaller operand to avoid rehashing while it grows.
 //
 // Time complexity: O(n) where n is size of the _other_ set.
 func (set HashSet[T]) Intersection(other Set[T]) Set[T] {
-	newset := NewHashSet[T]()
+	newset := make(HashSet[T], min(set.Len(), other.Len()))
 	for element := range other.All() {
 		if set.Contains(element) {
+			newset[element] = struct{}{}
+		}
+	}
+	return &newset
+}
+
+// IntersectionWhere returns a new set containing elements present in both sets that also satisfy the predicate.
+// Intersection and filtering happen in a single pass over the smaller set.
+//
+// Time complexity: O(min(n, m) * c) where n and m are the sizes of the sets and c is time complexity of the larger set's Contains() method.
+func (set HashSet[T]) IntersectionWhere(other Set[T], predicate func(T) bool) Set[T] {
+	newset := NewHashSet[T]()
+	smaller, larger := Set[T](&set), other
+	if other.Len() < set.Len() {
+		smaller, larger = other, &set
+	}
+	for element := range smaller.All() {
+		if larger.Contains(element) && predicate(element) {
 			newset.Add(element)
 		}
 	}
@@ -78,19 +147,48 @@ func (set HashSet[T]) Intersection(other Set[T]) Set[T] {
 }
 
 // Difference returns a new set containing elements in this set but not in the other.
+// The result is pre-sized to the current set to avoid rehashing while it grows,
+// at the cost of allocating room for every element even when most of them are removed.
 //
 // Time complexity: O(n * c) where n is size of the _current_ set and c is time complexity of the other set's Contains() method.
 //
 // For two HashSet implementations, this operates in O(n) average time, as Contains() is O(1).
 // If the other set has O(m) Contains() complexity (where m = its size), total complexity becomes O(n*m).
 func (set HashSet[T]) Difference(other Set[T]) Set[T] {
-	newset := NewHashSet[T]()
+	newset := make(HashSet[T], set.Len())
 	for element := range set.All() {
 		if !other.Contains(element) {
-			newset.Add(element)
+			newset[element] = struct{}{}
+		}
+	}
+	return &newset
+}
+
+// DifferenceTo calls sink for every element in this set but not in the other,
+// streaming the difference without building a result set.
+//
+// Time complexity: O(n * c) where n is size of the _current_ set and c is time complexity of the other set's Contains() method.
+func (set HashSet[T]) DifferenceTo(other Set[T], sink func(T)) {
+	for item := range set {
+		if !other.Contains(item) {
+			sink(item)
 		}
 	}
-	return newset
+}
+
+// DifferencePredicate returns a new set containing elements for which inOther is false,
+// i.e. the difference between this set and the virtual set {x : inOther(x)}.
+// It avoids materializing other sets that are huge or infinite.
+//
+// Time complexity: O(n) where n is size of the _current_ set.
+func (set HashSet[T]) DifferencePredicate(inOther func(T) bool) Set[T] {
+	newset := make(HashSet[T], len(set))
+	for item := range set {
+		if !inOther(item) {
+			newset[item] = struct{}{}
+		}
+	}
+	return &newset
 }
 
 // SymmetricDifference returns a new set containing elements present in exactly one set.
@@ -111,6 +209,82 @@ func (set HashSet[T]) SymmetricDifference(other Set[T]) Set[T] {
 	return &newset
 }
 
+// SymmetricDifferenceSize returns the number of elements present in exactly one set (|A △ B|)
+// without allocating the symmetric difference. This is the set analogue of Hamming distance.
+//
+// Time complexity: O(m) where m is size of the _other_ set.
+func (set HashSet[T]) SymmetricDifferenceSize(other Set[T]) int {
+	common := 0
+	for element := range other.All() {
+		if set.Contains(element) {
+			common++
+		}
+	}
+	return set.Len() + other.Len() - 2*common
+}
+
+// ConditionalOverlap returns the fraction of the given set's elements that are also in this set,
+// |receiver ∩ given| / |given|, analogous to the conditional probability P(A|B).
+// It returns 0 if the given set is empty.
+//
+// Time complexity: O(m) where m is size of the _given_ set.
+func (set HashSet[T]) ConditionalOverlap(given Set[T]) float64 {
+	if given.IsEmpty() {
+		return 0
+	}
+	return float64(intersectionLen(given, &set)) / float64(given.Len())
+}
+
+// EditDistance returns the minimal number of single-element additions and removals
+// that transform this set into the other, which equals |A △ B|.
+// It is computed without materializing the symmetric difference.
+//
+// Time complexity: O(m) where m is size of the _other_ set.
+func (set HashSet[T]) EditDistance(other Set[T]) int {
+	return set.SymmetricDifferenceSize(other)
+}
+
+// DifferingElements returns a new set containing elements whose membership differs between the two sets,
+// i.e. elements present in exactly one of them. Both sets are traversed through All(),
+// so the result is correct for any implementation of the other set.
+//
+// Time complexity: O(n * c + m) where n and m are the sizes of the sets and c is time complexity of the other set's Contains() method.
+func (set HashSet[T]) DifferingElements(other Set[T]) Set[T] {
+	newset := NewHashSet[T]()
+	for element := range set.All() {
+		if !other.Contains(element) {
+			newset.Add(element)
+		}
+	}
+	for element := range other.All() {
+		if !set.Contains(element) {
+			newset.Add(element)
+		}
+	}
+	return newset
+}
+
+// Categorize splits the union of both sets into three pairwise disjoint sets in a single traversal of each:
+// elements only in this set, elements in both, and elements only in the other set.
+//
+// Time complexity: O(n * c + m) where n and m are the sizes of the sets and c is time complexity of the other set's Contains() method.
+func (set HashSet[T]) Categorize(other Set[T]) (onlyThis, common, onlyOther Set[T]) {
+	this, both, that := NewHashSet[T](), NewHashSet[T](), NewHashSet[T]()
+	for item := range set {
+		if other.Contains(item) {
+			both.Add(item)
+		} else {
+			this.Add(item)
+		}
+	}
+	for element := range other.All() {
+		if !set.Contains(element) {
+			that.Add(element)
+		}
+	}
+	return this, both, that
+}
+
 // Merge adds all elements from the other set to this set (in-place union).
 //
 // Time complexity: O(n) where n is size of the _other_ set.
@@ -120,6 +294,43 @@ func (set *HashSet[T]) Merge(other Set[T]) {
 	}
 }
 
+// MergeBounded adds elements from the other set to this set until it holds maxSize elements,
+// returning how many new elements were dropped for lack of room.
+// Elements already present are never counted as dropped.
+//
+// New elements are taken in ascending order by less, so the smallest ones are kept
+// and the result does not depend on iteration order.
+//
+// Time complexity: O(n log n) where n is size of the _other_ set.
+func (set *HashSet[T]) MergeBounded(other Set[T], maxSize int, less func(a, b T) bool) (dropped int) {
+	var added []T
+	for element := range other.All() {
+		if !set.Contains(element) {
+			added = append(added, element)
+		}
+	}
+	slices.SortFunc(added, compareByLess(less))
+	room := min(max(maxSize-set.Len(), 0), len(added))
+	for _, element := range added[:room] {
+		set.Add(element)
+	}
+	return len(added) - room
+}
+
+// compareByLess turns a less function into a comparison function for slices.SortFunc.
+func compareByLess[T any](less func(a, b T) bool) func(a, b T) int {
+	return func(a, b T) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		default:
+			return 0
+		}
+	}
+}
+
 // Retain keeps only elements present in both sets (in-place intersection).
 //
 // Time complexity: O(n * c) where n is size of the _current_ set and c is time complexity of the other set's Contains() method.
@@ -161,6 +372,22 @@ func (set *HashSet[T]) Xor(other Set[T]) {
 	}
 }
 
+// XorReporting performs an in-place symmetric difference like Xor and reports the elements it added and removed.
+//
+// Time complexity: O(n) where n is size of the _other_ set.
+func (set *HashSet[T]) XorReporting(other Set[T]) (added, removed []T) {
+	for element := range other.All() {
+		if set.Contains(element) {
+			set.Remove(element)
+			removed = append(removed, element)
+		} else {
+			set.Add(element)
+			added = append(added, element)
+		}
+	}
+	return added, removed
+}
+
 // Equals reports whether two sets contain identical elements.
 //
 // Time complexity: O(l + (n * c)) where l is time complexity of the _other_ set's All() method and n is size of the _current_ set and c is time complexity of the _other_ set's Contains() method.
@@ -178,6 +405,15 @@ func (set HashSet[T]) Equals(other Set[T]) bool {
 	return true
 }
 
+// Equal reports whether two HashSets contain identical elements.
+// Its signature matches the custom equality method recognized by go-cmp,
+// so cmp.Equal and cmp.Diff compare HashSets by membership rather than as maps.
+//
+// Time complexity: O(n) where n is size of the _current_ set.
+func (set HashSet[T]) Equal(other HashSet[T]) bool {
+	return set.Equals(&other)
+}
+
 // IsSuperset reports whether this set contains all elements of the other set.
 //
 // Time complexity: O(l + (n * c)) where l is time complexity of the _other_ set's Len() method and n is size of the _current_ set and c is time complexity of the _other_ set's Contains() method.
@@ -222,6 +458,16 @@ func (set HashSet[T]) Elements() []T {
 	return elements
 }
 
+// OrderedElements returns a slice containing all set elements sorted by the less function.
+// It allows stable output for element types that are not cmp.Ordered.
+//
+// Time complexity: O(n log n) where n is the size of the set.
+func (set HashSet[T]) OrderedElements(less func(a, b T) bool) []T {
+	elements := set.Elements()
+	slices.SortFunc(elements, compareByLess(less))
+	return elements
+}
+
 // Clone returns a copy of the set.
 func (set HashSet[T]) Clone() Set[T] {
 	cloned := make(HashSet[T], len(set))
@@ -231,16 +477,52 @@ func (set HashSet[T]) Clone() Set[T] {
 	return &cloned
 }
 
+// Snapshot records the current membership and returns a closure that restores the set to it,
+// removing elements added since and re-adding elements removed since.
+// The restore closure may be called any number of times.
+//
+// Time complexity: O(n) to take the snapshot and O(n + m) to restore, where n is the size
+// of the set at snapshot time and m is its size at restore time.
+func (set *HashSet[T]) Snapshot() func() {
+	snapshot := maps.Clone(*set)
+	return func() {
+		for item := range *set {
+			if _, ok := snapshot[item]; !ok {
+				set.Remove(item)
+			}
+		}
+		for item := range snapshot {
+			set.Add(item)
+		}
+	}
+}
+
 // All returns an iterator for ranging over elements.
 func (set HashSet[T]) All() iter.Seq[T] {
 	return maps.Keys(set)
 }
 
+// AllWithMembership returns an iterator over the set's elements, each paired with whether it is also in the other set.
+func (set HashSet[T]) AllWithMembership(other Set[T]) iter.Seq2[T, bool] {
+	return func(yield func(T, bool) bool) {
+		for item := range set {
+			if !yield(item, other.Contains(item)) {
+				return
+			}
+		}
+	}
+}
+
 // Len returns the number of elements in the set.
 func (set HashSet[T]) Len() int {
 	return len(set)
 }
 
+// IsEmpty reports whether the set contains no elements.
+func (set HashSet[T]) IsEmpty() bool {
+	return len(set) == 0
+}
+
 // String returns a human-readable representation in the format "Set{e1, e2, ...}".
 func (set HashSet[T]) String() string {
 	elements := make([]string, 0, len(set))
@@ -249,3 +531,148 @@ func (set HashSet[T]) String() string {
 	}
 	return fmt.Sprintf("Set{%s}", strings.Join(elements, ", "))
 }
+
+// StringFunc returns a human-readable representation in the format "Set{e1, e2, ...}",
+// formatting each element with the supplied function instead of %v.
+// Formatted elements are sorted, so the output is deterministic.
+func (set HashSet[T]) StringFunc(format func(T) string) string {
+	elements := make([]string, 0, len(set))
+	for item := range set {
+		elements = append(elements, format(item))
+	}
+	slices.Sort(elements)
+	return fmt.Sprintf("Set{%s}", strings.Join(elements, ", "))
+}
+
+// WeightedSample draws one element with probability proportional to its weight,
+// using a single-pass weighted reservoir. Weights must be non-negative; elements with
+// zero weight are never chosen. It returns false if the set is empty or all weights are zero.
+// It panics if a weight is negative.
+//
+// Random draws are matched to elements in iteration order, which is undefined for HashSet,
+// so the same seed does not reproduce the same choice; only the distribution is fixed.
+//
+// Time complexity: O(n) where n is the size of the set.
+func (set HashSet[T]) WeightedSample(r *rand.Rand, weight func(T) float64) (T, bool) {
+	var chosen T
+	var total float64
+	for item := range set {
+		w := weight(item)
+		if w < 0 {
+			panic("goset: negative weight in WeightedSample")
+		}
+		if w == 0 {
+			continue
+		}
+		total += w
+		if r.Float64()*total < w {
+			chosen = item
+		}
+	}
+	return chosen, total > 0
+}
+
+// SplitRatio randomly partitions the set into two disjoint sets covering it,
+// assigning each element to a with probability ratio and to b otherwise.
+//
+// Each element consumes one random draw in iteration order, which is undefined for HashSet.
+// A fixed seed therefore reproduces the sizes of the two parts but not which elements land in each.
+//
+// Time complexity: O(n) where n is the size of the set.
+func (set HashSet[T]) SplitRatio(r *rand.Rand, ratio float64) (a Set[T], b Set[T]) {
+	first, second := NewHashSet[T](), NewHashSet[T]()
+	for item := range set {
+		if r.Float64() < ratio {
+			first.Add(item)
+		} else {
+			second.Add(item)
+		}
+	}
+	return first, second
+}
+
+// RandomSubset returns a new set of k distinct elements chosen uniformly at random using reservoir sampling.
+// If k is at least the size of the set, all elements are returned.
+//
+// Elements are fed to the reservoir in iteration order, which is undefined for HashSet,
+// so a fixed seed does not reproduce which elements are chosen; only the size is fixed.
+//
+// Time complexity: O(n) where n is the size of the set.
+func (set HashSet[T]) RandomSubset(r *rand.Rand, k int) Set[T] {
+	reservoir := make([]T, 0, max(0, min(k, len(set))))
+	seen := 0
+	for item := range set {
+		seen++
+		if len(reservoir) < k {
+			reservoir = append(reservoir, item)
+		} else if j := r.IntN(seen); j < k {
+			reservoir[j] = item
+		}
+	}
+	return NewHashSet(reservoir...)
+}
+
+// SampleWithReplacement returns n elements drawn uniformly at random with replacement,
+// so the result may contain repeats. It returns nil if the set is empty or n is not positive.
+//
+// Draws index into the set's iteration order, which is undefined for HashSet, so a fixed seed
+// reproduces the same pattern of repeats but not necessarily the same elements.
+//
+// Time complexity: O(s + n) where s is the size of the set.
+func (set HashSet[T]) SampleWithReplacement(r *rand.Rand, n int) []T {
+	if len(set) == 0 || n <= 0 {
+		return nil
+	}
+	elements := set.Elements()
+	samples := make([]T, n)
+	for i := range samples {
+		samples[i] = elements[r.IntN(len(elements))]
+	}
+	return samples
+}
+
+// UnionHashSet returns a new HashSet containing all elements present in either set.
+// Unlike the Union method it takes concrete HashSets, avoiding interface dispatch in hot loops.
+//
+// Time complexity: O(n + m) where n and m are the sizes of the sets.
+func UnionHashSet[T comparable](a, b HashSet[T]) HashSet[T] {
+	newset := make(HashSet[T], len(a)+len(b))
+	for item := range a {
+		newset[item] = struct{}{}
+	}
+	for item := range b {
+		newset[item] = struct{}{}
+	}
+	return newset
+}
+
+// IntersectionHashSet returns a new HashSet containing elements present in both sets.
+// Unlike the Intersection method it takes concrete HashSets, avoiding interface dispatch in hot loops.
+//
+// Time complexity: O(min(n, m)) where n and m are the sizes of the sets.
+func IntersectionHashSet[T comparable](a, b HashSet[T]) HashSet[T] {
+	if len(b) < len(a) {
+		a, b = b, a
+	}
+	newset := make(HashSet[T], len(a))
+	for item := range a {
+		if _, ok := b[item]; ok {
+			newset[item] = struct{}{}
+		}
+	}
+	return newset
+}
+
+// DifferenceHashSet returns a new HashSet containing elements in a but not in b.
+// Unlike the Difference method it takes concrete HashSets, avoiding interface dispatch in hot loops.
+//
+// Time complexity: O(n) where n is the size of a.
+func DifferenceHashSet[T comparable](a, b HashSet[T]) HashSet[T] {
+	newset := make(HashSet[T], len(a))
+	for item := range a {
+		if _, ok := b[item]; !ok {
+			newset[item] = struct{}{}
+		}
+	}
+	return newset
+}
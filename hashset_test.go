@@ -0,0 +1,670 @@
+package goset
+
+import (
+	"fmt"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// equalMethodReporter records whether cmp decided equality by calling an Equal method.
+type equalMethodReporter struct {
+	byMethod bool
+}
+
+func (r *equalMethodReporter) PushStep(cmp.PathStep) {}
+func (r *equalMethodReporter) PopStep()              {}
+func (r *equalMethodReporter) Report(result cmp.Result) {
+	r.byMethod = r.byMethod || result.ByMethod()
+}
+
+func TestHashSetEqualCmp(t *testing.T) {
+	a := make(HashSet[int])
+	b := make(HashSet[int])
+	for i := range 100 {
+		a[i] = struct{}{}
+		b[99-i] = struct{}{}
+	}
+	reporter := &equalMethodReporter{}
+	if !cmp.Equal(a, b, cmp.Reporter(reporter)) {
+		t.Errorf("cmp.Equal on equal sets = false, want true")
+	}
+	if !reporter.byMethod {
+		t.Error("cmp.Equal did not use HashSet.Equal")
+	}
+	if diff := cmp.Diff(a, b); diff != "" {
+		t.Errorf("cmp.Diff on equal sets is not empty:\n%s", diff)
+	}
+
+	delete(b, 42)
+	if cmp.Equal(a, b) {
+		t.Error("cmp.Equal on different sets = true, want false")
+	}
+}
+
+func TestHashSetEqualCmpPointer(t *testing.T) {
+	// *HashSet has no Equal(*HashSet) method, so cmp dereferences the pointers
+	// and then reaches HashSet.Equal on the values.
+	a := NewHashSet(1, 2, 3)
+	b := NewHashSet(3, 2, 1)
+	reporter := &equalMethodReporter{}
+	if !cmp.Equal(a, b, cmp.Reporter(reporter)) {
+		t.Error("cmp.Equal(*HashSet) on equal sets = false, want true")
+	}
+	if !reporter.byMethod {
+		t.Error("cmp.Equal(*HashSet) did not use HashSet.Equal")
+	}
+
+	b.Add(4)
+	if cmp.Equal(a, b) {
+		t.Error("cmp.Equal(*HashSet) on different sets = true, want false")
+	}
+}
+
+func TestHashSetCanAdd(t *testing.T) {
+	set := NewHashSet(1, 2, 3)
+	tests := []struct {
+		name    string
+		element int
+		maxSize int
+		want    bool
+	}{
+		{name: "already present at cap", element: 2, maxSize: 3, want: true},
+		{name: "under cap", element: 4, maxSize: 4, want: true},
+		{name: "at cap", element: 4, maxSize: 3, want: false},
+	}
+	for _, tt := range tests {
+		if got := set.CanAdd(tt.element, tt.maxSize); got != tt.want {
+			t.Errorf("%s: CanAdd(%d, %d) = %v, want %v", tt.name, tt.element, tt.maxSize, got, tt.want)
+		}
+	}
+}
+
+func TestHashSetIntersectionWhere(t *testing.T) {
+	set := NewHashSet(1, 2, 3, 4, 5)
+	other := NewHashSet(2, 3, 4, 6)
+	tests := []struct {
+		name      string
+		predicate func(int) bool
+		want      []int
+	}{
+		{name: "keep all", predicate: func(int) bool { return true }, want: []int{2, 3, 4}},
+		{name: "keep none", predicate: func(int) bool { return false }, want: []int{}},
+		{name: "keep even", predicate: func(x int) bool { return x%2 == 0 }, want: []int{2, 4}},
+	}
+	for _, tt := range tests {
+		if got := sortedElements(set.IntersectionWhere(other, tt.predicate)); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: IntersectionWhere = %v, want %v", tt.name, got, tt.want)
+		}
+		// Swapping operands exercises iterating the other, smaller side.
+		if got := sortedElements(other.IntersectionWhere(set, tt.predicate)); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: swapped IntersectionWhere = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestHashSetDifferingElements(t *testing.T) {
+	set := NewHashSet(1, 2, 3)
+	other := NewSyncSet[int](NewHashSet(2, 3, 4))
+	want := []int{1, 4}
+	if got := sortedElements(set.DifferingElements(other)); !slices.Equal(got, want) {
+		t.Errorf("DifferingElements = %v, want %v", got, want)
+	}
+	if got := sortedElements(set.DifferingElements(other)); !slices.Equal(got, sortedElements(set.SymmetricDifference(other))) {
+		t.Errorf("DifferingElements = %v, differs from SymmetricDifference", got)
+	}
+}
+
+// benchmarkOperands returns two sets of size n overlapping in half of their elements.
+func benchmarkOperands(n int) (HashSet[int], HashSet[int]) {
+	a := make(HashSet[int], n)
+	b := make(HashSet[int], n)
+	for i := range n {
+		a[i] = struct{}{}
+		b[i+n/2] = struct{}{}
+	}
+	return a, b
+}
+
+func TestConcreteHashSetOperations(t *testing.T) {
+	a, b := benchmarkOperands(100)
+	if got := UnionHashSet(a, b); !got.Equals(a.Union(&b)) {
+		t.Errorf("UnionHashSet = %v, differs from Union", got)
+	}
+	if got := IntersectionHashSet(a, b); !got.Equals(a.Intersection(&b)) {
+		t.Errorf("IntersectionHashSet = %v, differs from Intersection", got)
+	}
+	if got := DifferenceHashSet(a, b); !got.Equals(a.Difference(&b)) {
+		t.Errorf("DifferenceHashSet = %v, differs from Difference", got)
+	}
+	if got := DifferenceHashSet(b, a); !got.Equals(b.Difference(&a)) {
+		t.Errorf("DifferenceHashSet(b, a) = %v, differs from Difference", got)
+	}
+}
+
+func BenchmarkUnion(b *testing.B) {
+	x, y := benchmarkOperands(1000)
+	b.Run("interface", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			x.Union(&y)
+		}
+	})
+	b.Run("concrete", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			UnionHashSet(x, y)
+		}
+	})
+}
+
+func BenchmarkIntersection(b *testing.B) {
+	x, y := benchmarkOperands(1000)
+	b.Run("interface", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			x.Intersection(&y)
+		}
+	})
+	b.Run("concrete", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			IntersectionHashSet(x, y)
+		}
+	})
+}
+
+func BenchmarkDifference(b *testing.B) {
+	x, y := benchmarkOperands(1000)
+	b.Run("interface", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			x.Difference(&y)
+		}
+	})
+	b.Run("concrete", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			DifferenceHashSet(x, y)
+		}
+	})
+}
+
+func TestHashSetSymmetricDifferenceSize(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, b  []int
+		other func(...int) Set[int]
+	}{
+		{"identical", []int{1, 2, 3}, []int{1, 2, 3}, nil},
+		{"disjoint", []int{1, 2}, []int{3, 4, 5}, nil},
+		{"partial", []int{1, 2, 3}, []int{2, 3, 4}, nil},
+		{"empty", nil, []int{1}, nil},
+		{"sync other", []int{1, 2, 3}, []int{3, 4}, func(elements ...int) Set[int] {
+			return NewSyncSet[int](NewHashSet(elements...))
+		}},
+	}
+	for _, tt := range tests {
+		a := NewHashSet(tt.a...)
+		var b Set[int] = NewHashSet(tt.b...)
+		if tt.other != nil {
+			b = tt.other(tt.b...)
+		}
+		want := a.SymmetricDifference(b).Len()
+		if got := a.SymmetricDifferenceSize(b); got != want {
+			t.Errorf("%s: SymmetricDifferenceSize = %d, want %d", tt.name, got, want)
+		}
+	}
+}
+
+func TestHashSetWeightedSample(t *testing.T) {
+	// The seed fixes the random stream but not the iteration order, so only frequencies are checked.
+	r := rand.New(rand.NewPCG(1, 2))
+	s := NewHashSet("heavy", "light", "never")
+	weights := map[string]float64{"heavy": 9, "light": 1, "never": 0}
+	counts := make(map[string]int)
+	const draws = 10000
+	for range draws {
+		element, ok := s.WeightedSample(r, func(e string) float64 { return weights[e] })
+		if !ok {
+			t.Fatal("WeightedSample returned false for positive weights")
+		}
+		counts[element]++
+	}
+	if counts["never"] != 0 {
+		t.Errorf("zero-weight element chosen %d times", counts["never"])
+	}
+	// The expected share of "heavy" is 0.9; allow a wide margin around it.
+	if share := float64(counts["heavy"]) / draws; share < 0.85 || share > 0.95 {
+		t.Errorf("heavy element chosen with share %.3f, want about 0.9", share)
+	}
+}
+
+func TestHashSetWeightedSampleZeroWeights(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	zero := func(int) float64 { return 0 }
+	if _, ok := NewHashSet(1, 2, 3).WeightedSample(r, zero); ok {
+		t.Error("WeightedSample with all-zero weights returned true")
+	}
+	if _, ok := NewHashSet[int]().WeightedSample(r, zero); ok {
+		t.Error("WeightedSample on empty set returned true")
+	}
+}
+
+func TestHashSetWeightedSampleNegativeWeight(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("WeightedSample with negative weight did not panic")
+		}
+	}()
+	r := rand.New(rand.NewPCG(1, 2))
+	NewHashSet(1).WeightedSample(r, func(int) float64 { return -1 })
+}
+
+func TestHashSetIntersectionAndDifference(t *testing.T) {
+	tests := []struct {
+		name          string
+		a, b          []int
+		intersection  []int
+		difference    []int
+		differenceOfB []int
+	}{
+		{"identical", []int{1, 2, 3}, []int{1, 2, 3}, []int{1, 2, 3}, []int{}, []int{}},
+		{"disjoint", []int{1, 2}, []int{3, 4}, []int{}, []int{1, 2}, []int{3, 4}},
+		{"partial", []int{1, 2, 3}, []int{2, 3, 4, 5}, []int{2, 3}, []int{1}, []int{4, 5}},
+		{"empty", nil, []int{1}, []int{}, []int{}, []int{1}},
+	}
+	for _, tt := range tests {
+		a, b := NewHashSet(tt.a...), NewHashSet(tt.b...)
+		if got := sortedElements(a.Intersection(b)); !slices.Equal(got, tt.intersection) {
+			t.Errorf("%s: Intersection = %v, want %v", tt.name, got, tt.intersection)
+		}
+		if got := sortedElements(a.Difference(b)); !slices.Equal(got, tt.difference) {
+			t.Errorf("%s: Difference = %v, want %v", tt.name, got, tt.difference)
+		}
+		if got := sortedElements(b.Difference(a)); !slices.Equal(got, tt.differenceOfB) {
+			t.Errorf("%s: reverse Difference = %v, want %v", tt.name, got, tt.differenceOfB)
+		}
+	}
+}
+
+// unsizedIntersection and unsizedDifference grow their result from an empty map,
+// as Intersection and Difference did before pre-sizing; they serve as the benchmark baseline.
+func unsizedIntersection[T comparable](set HashSet[T], other Set[T]) Set[T] {
+	newset := make(HashSet[T])
+	for element := range other.All() {
+		if set.Contains(element) {
+			newset[element] = struct{}{}
+		}
+	}
+	return &newset
+}
+
+func unsizedDifference[T comparable](set HashSet[T], other Set[T]) Set[T] {
+	newset := make(HashSet[T])
+	for element := range set {
+		if !other.Contains(element) {
+			newset[element] = struct{}{}
+		}
+	}
+	return &newset
+}
+
+// BenchmarkPresizing compares the pre-sized Intersection and Difference with unsized baselines.
+// The identical case is the worst case for pre-sizing: the difference is empty but
+// still allocates buckets for every element of the receiver.
+func BenchmarkPresizing(b *testing.B) {
+	x, y := benchmarkOperands(1000)
+	identical := x.Clone()
+	cases := []struct {
+		name string
+		op   func()
+	}{
+		{"Intersection/presized", func() { x.Intersection(&y) }},
+		{"Intersection/unsized", func() { unsizedIntersection[int](x, &y) }},
+		{"Difference/presized", func() { x.Difference(&y) }},
+		{"Difference/unsized", func() { unsizedDifference[int](x, &y) }},
+		{"DifferenceIdentical/presized", func() { x.Difference(identical) }},
+		{"DifferenceIdentical/unsized", func() { unsizedDifference(x, identical) }},
+	}
+	for _, bc := range cases {
+		b.Run(bc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				bc.op()
+			}
+		})
+	}
+}
+
+func TestHashSetSnapshot(t *testing.T) {
+	s := NewHashSet(1, 2, 3)
+	restore := s.Snapshot()
+
+	s.Add(4)
+	s.Remove(1)
+	s.Remove(2)
+	s.Add(2)
+	restore()
+	if want := NewHashSet(1, 2, 3); !s.Equals(want) {
+		t.Errorf("after restore: %v, want %v", s, want)
+	}
+
+	s.Subtract(s.Clone())
+	restore()
+	if want := NewHashSet(1, 2, 3); !s.Equals(want) {
+		t.Errorf("after second restore: %v, want %v", s, want)
+	}
+}
+
+func TestCountDistinct(t *testing.T) {
+	set, counts := CountDistinct([]string{"a", "b", "a", "c", "a", "b"})
+	if got, want := sortedElements[string](&set), []string{"a", "b", "c"}; !slices.Equal(got, want) {
+		t.Errorf("CountDistinct set = %v, want %v", got, want)
+	}
+	want := map[string]int{"a": 3, "b": 2, "c": 1}
+	if !maps.Equal(counts, want) {
+		t.Errorf("CountDistinct counts = %v, want %v", counts, want)
+	}
+}
+
+func TestHashSetDifferenceTo(t *testing.T) {
+	a, b := NewHashSet(1, 2, 3, 4), NewHashSet(2, 4, 6)
+	var got []int
+	a.DifferenceTo(b, func(element int) {
+		got = append(got, element)
+	})
+	slices.Sort(got)
+	want := a.Difference(b).Elements()
+	slices.Sort(want)
+	if !slices.Equal(got, want) {
+		t.Errorf("DifferenceTo sink received %v, want %v", got, want)
+	}
+}
+
+func TestHashSetAllWithMembership(t *testing.T) {
+	a, b := NewHashSet(1, 2, 3), NewHashSet(2, 3, 4)
+	got := maps.Collect(a.AllWithMembership(b))
+	want := map[int]bool{1: false, 2: true, 3: true}
+	if !maps.Equal(got, want) {
+		t.Errorf("AllWithMembership = %v, want %v", got, want)
+	}
+}
+
+func TestHashSetSplitRatio(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	s := NewHashSet[int]()
+	for i := range 1000 {
+		s.Add(i)
+	}
+	train, test := s.SplitRatio(r, 0.8)
+	if common := train.Intersection(test); common.Len() != 0 {
+		t.Errorf("splits share elements %v", common)
+	}
+	if !train.Union(test).Equals(s) {
+		t.Error("splits do not cover the original set")
+	}
+	// The expected size of the first split is 800; allow a wide margin around it.
+	if n := train.Len(); n < 750 || n > 850 {
+		t.Errorf("first split has %d elements, want about 800", n)
+	}
+
+	// The same seed draws the same sequence, so the part sizes repeat even though membership may not.
+	again, _ := s.SplitRatio(rand.New(rand.NewPCG(1, 2)), 0.8)
+	if again.Len() != train.Len() {
+		t.Errorf("same seed gave first splits of %d and %d elements, want equal", train.Len(), again.Len())
+	}
+}
+
+func TestHashSetStringFunc(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	s := NewHashSet(user{3, "carol"}, user{1, "alice"}, user{2, "bob"})
+	got := s.StringFunc(func(u user) string { return fmt.Sprintf("#%d", u.id) })
+	if want := "Set{#1, #2, #3}"; got != want {
+		t.Errorf("StringFunc = %q, want %q", got, want)
+	}
+	if got, want := NewHashSet[user]().StringFunc(nil), "Set{}"; got != want {
+		t.Errorf("StringFunc on empty set = %q, want %q", got, want)
+	}
+}
+
+func TestHashSetMergeBounded(t *testing.T) {
+	tests := []struct {
+		name        string
+		set, other  []int
+		maxSize     int
+		wantDropped int
+		want        []int
+	}{
+		{"fits", []int{1, 2}, []int{3, 4}, 5, 0, []int{1, 2, 3, 4}},
+		{"overlap fits", []int{1, 2, 3}, []int{2, 3, 4}, 4, 0, []int{1, 2, 3, 4}},
+		{"overflows keeps smallest", []int{1, 2}, []int{6, 3, 5, 4}, 4, 2, []int{1, 2, 3, 4}},
+		{"already full", []int{1, 2, 3}, []int{3, 4}, 3, 1, []int{1, 2, 3}},
+		{"over full", []int{1, 2, 3}, []int{4}, 2, 1, []int{1, 2, 3}},
+	}
+	less := func(a, b int) bool { return a < b }
+	for _, tt := range tests {
+		s := NewHashSet(tt.set...)
+		dropped := s.MergeBounded(NewHashSet(tt.other...), tt.maxSize, less)
+		if dropped != tt.wantDropped {
+			t.Errorf("%s: MergeBounded dropped %d, want %d", tt.name, dropped, tt.wantDropped)
+		}
+		if !s.Equals(NewHashSet(tt.want...)) {
+			t.Errorf("%s: MergeBounded result = %v, want %v", tt.name, sortedElements(s), tt.want)
+		}
+	}
+}
+
+func TestHashSetConditionalOverlap(t *testing.T) {
+	s := NewHashSet(1, 2, 3, 4)
+	tests := []struct {
+		name  string
+		given Set[int]
+		want  float64
+	}{
+		{"full containment", NewHashSet(2, 3), 1},
+		{"disjoint", NewHashSet(5, 6), 0},
+		{"partial", NewHashSet(3, 4, 5, 6), 0.5},
+		{"empty given", NewHashSet[int](), 0},
+	}
+	for _, tt := range tests {
+		if got := s.ConditionalOverlap(tt.given); got != tt.want {
+			t.Errorf("%s: ConditionalOverlap = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestHashSetOrderedElements(t *testing.T) {
+	type version struct {
+		major, minor int
+	}
+	s := NewHashSet(version{1, 10}, version{2, 0}, version{1, 2}, version{0, 9})
+	got := s.OrderedElements(func(a, b version) bool {
+		return a.major < b.major || a.major == b.major && a.minor < b.minor
+	})
+	want := []version{{0, 9}, {1, 2}, {1, 10}, {2, 0}}
+	if !slices.Equal(got, want) {
+		t.Errorf("OrderedElements = %v, want %v", got, want)
+	}
+}
+
+func TestHashSetEditDistance(t *testing.T) {
+	pairs := []struct {
+		a, b []int
+		want int
+	}{
+		{[]int{1, 2, 3}, []int{1, 2, 3}, 0},
+		{[]int{1, 2}, []int{3, 4}, 4},
+		{[]int{1, 2, 3}, []int{2, 3, 4, 5}, 3},
+		{nil, []int{1, 2}, 2},
+	}
+	for _, tt := range pairs {
+		a, b := NewHashSet(tt.a...), NewHashSet(tt.b...)
+		got := a.EditDistance(b)
+		if want := a.SymmetricDifference(b).Len(); got != want || got != tt.want {
+			t.Errorf("EditDistance(%v, %v) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestHashSetRandomSubset(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	s := NewHashSet(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	tests := []struct {
+		k, wantLen int
+	}{
+		{k: 0, wantLen: 0},
+		{k: 3, wantLen: 3},
+		{k: 9, wantLen: 9},
+		{k: 10, wantLen: 10},
+		{k: 20, wantLen: 10},
+	}
+	for _, tt := range tests {
+		subset := s.RandomSubset(r, tt.k)
+		if subset.Len() != tt.wantLen {
+			t.Errorf("RandomSubset(%d) has %d elements, want %d", tt.k, subset.Len(), tt.wantLen)
+		}
+		if !subset.IsSubset(s) {
+			t.Errorf("RandomSubset(%d) = %v is not a subset of %v", tt.k, subset, s)
+		}
+		if tt.k < s.Len() && subset.Equals(s) {
+			t.Errorf("RandomSubset(%d) = %v, want a proper subset", tt.k, subset)
+		}
+	}
+}
+
+// repeatProfile returns how many times each distinct sample was drawn, in ascending order.
+// It is independent of which element got which label, unlike the samples themselves.
+func repeatProfile[T comparable](samples []T) []int {
+	counts := make(map[T]int)
+	for _, sample := range samples {
+		counts[sample]++
+	}
+	return slices.Sorted(maps.Values(counts))
+}
+
+func TestHashSetSampleWithReplacement(t *testing.T) {
+	s := NewHashSet(1, 2, 3, 4, 5)
+	first := s.SampleWithReplacement(rand.New(rand.NewPCG(1, 2)), 50)
+	second := s.SampleWithReplacement(rand.New(rand.NewPCG(1, 2)), 50)
+	if len(first) != 50 || len(second) != 50 {
+		t.Fatalf("SampleWithReplacement returned %d and %d samples, want 50", len(first), len(second))
+	}
+	for _, sample := range first {
+		if !s.Contains(sample) {
+			t.Errorf("sample %d is not a member of %v", sample, s)
+		}
+	}
+	if a, b := repeatProfile(first), repeatProfile(second); !slices.Equal(a, b) {
+		t.Errorf("same seed gave repeat profiles %v and %v, want equal", a, b)
+	}
+
+	// With a single element the iteration order is fixed, so the samples are reproduced exactly.
+	single := NewHashSet(7)
+	if got := single.SampleWithReplacement(rand.New(rand.NewPCG(1, 2)), 3); !slices.Equal(got, []int{7, 7, 7}) {
+		t.Errorf("SampleWithReplacement of single element = %v, want [7 7 7]", got)
+	}
+
+	r := rand.New(rand.NewPCG(1, 2))
+	for _, n := range []int{0, -1} {
+		if got := s.SampleWithReplacement(r, n); got != nil {
+			t.Errorf("SampleWithReplacement(%d) = %v, want nil", n, got)
+		}
+	}
+	if got := NewHashSet[int]().SampleWithReplacement(r, 3); got != nil {
+		t.Errorf("SampleWithReplacement on empty set = %v, want nil", got)
+	}
+}
+
+func TestHashSetDifferencePredicate(t *testing.T) {
+	s := NewHashSet(1, 2, 3, 4, 5, 6)
+	even := func(n int) bool { return n%2 == 0 }
+	if got, want := sortedElements(s.DifferencePredicate(even)), []int{1, 3, 5}; !slices.Equal(got, want) {
+		t.Errorf("DifferencePredicate(even) = %v, want %v", got, want)
+	}
+	if got := s.DifferencePredicate(func(int) bool { return true }); got.Len() != 0 {
+		t.Errorf("DifferencePredicate(all) = %v, want empty", got)
+	}
+	if s.Len() != 6 {
+		t.Errorf("DifferencePredicate modified the receiver: %v", s)
+	}
+}
+
+func TestHashSetWouldChange(t *testing.T) {
+	s := NewHashSet(1, 2, 3)
+	tests := []struct {
+		elements      []int
+		add, onRemove bool
+	}{
+		{elements: []int{1, 2}, add: false, onRemove: true},
+		{elements: []int{1, 4}, add: true, onRemove: true},
+		{elements: []int{4, 5}, add: true, onRemove: false},
+		{elements: nil, add: false, onRemove: false},
+	}
+	for _, tt := range tests {
+		if got := s.WouldChange(tt.elements...); got != tt.add {
+			t.Errorf("WouldChange(%v) = %v, want %v", tt.elements, got, tt.add)
+		}
+		if got := s.WouldChangeOnRemove(tt.elements...); got != tt.onRemove {
+			t.Errorf("WouldChangeOnRemove(%v) = %v, want %v", tt.elements, got, tt.onRemove)
+		}
+	}
+}
+
+func TestHashSetXorReporting(t *testing.T) {
+	s := NewHashSet(1, 2, 3)
+	added, removed := s.XorReporting(NewHashSet(2, 3, 4, 5))
+	slices.Sort(added)
+	slices.Sort(removed)
+	if want := []int{4, 5}; !slices.Equal(added, want) {
+		t.Errorf("XorReporting added %v, want %v", added, want)
+	}
+	if want := []int{2, 3}; !slices.Equal(removed, want) {
+		t.Errorf("XorReporting removed %v, want %v", removed, want)
+	}
+	if want := NewHashSet(1, 4, 5); !s.Equals(want) {
+		t.Errorf("after XorReporting: %v, want %v", s, want)
+	}
+
+	// Toggling the same elements again restores the original set.
+	added, removed = s.XorReporting(NewHashSet(2, 3, 4, 5))
+	slices.Sort(added)
+	slices.Sort(removed)
+	if !slices.Equal(added, []int{2, 3}) || !slices.Equal(removed, []int{4, 5}) {
+		t.Errorf("second XorReporting added %v and removed %v, want [2 3] and [4 5]", added, removed)
+	}
+	if want := NewHashSet(1, 2, 3); !s.Equals(want) {
+		t.Errorf("after second XorReporting: %v, want %v", s, want)
+	}
+}
+
+func TestHashSetCategorize(t *testing.T) {
+	a, b := NewHashSet(1, 2, 3, 4), NewHashSet(3, 4, 5)
+	onlyThis, common, onlyOther := a.Categorize(b)
+	parts := []struct {
+		name string
+		got  Set[int]
+		want []int
+	}{
+		{"onlyThis", onlyThis, []int{1, 2}},
+		{"common", common, []int{3, 4}},
+		{"onlyOther", onlyOther, []int{5}},
+	}
+	for _, part := range parts {
+		if got := sortedElements(part.got); !slices.Equal(got, part.want) {
+			t.Errorf("Categorize %s = %v, want %v", part.name, got, part.want)
+		}
+	}
+	if !PairwiseDisjoint(onlyThis, common, onlyOther) {
+		t.Error("Categorize parts are not pairwise disjoint")
+	}
+	if union := onlyThis.Union(common).Union(onlyOther); !union.Equals(a.Union(b)) {
+		t.Errorf("union of Categorize parts = %v, want %v", union, a.Union(b))
+	}
+}
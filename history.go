@@ -0,0 +1,72 @@
+package goset
+
+// PersistentAcross returns a new set containing elements present in every version,
+// i.e. the intersection of all versions. An empty history yields an empty set.
+//
+// Time complexity: O(n * k) where n is the size of the first version and k is the number of versions.
+func PersistentAcross[T comparable](versions []Set[T]) Set[T] {
+	newset := NewHashSet[T]()
+	if len(versions) == 0 {
+		return newset
+	}
+	for element := range versions[0].All() {
+		persistent := true
+		for _, version := range versions[1:] {
+			if !version.Contains(element) {
+				persistent = false
+				break
+			}
+		}
+		if persistent {
+			newset.Add(element)
+		}
+	}
+	return newset
+}
+
+// MaxStableRun returns the length of the longest run of consecutive versions containing the element.
+//
+// Time complexity: O(k * c) where k is the number of versions and c is time complexity of their Contains() method.
+func MaxStableRun[T comparable](versions []Set[T], element T) int {
+	longest, run := 0, 0
+	for _, version := range versions {
+		if version.Contains(element) {
+			run++
+			longest = max(longest, run)
+		} else {
+			run = 0
+		}
+	}
+	return longest
+}
+
+// StabilityScores returns, for every element seen in any version, the fraction of versions containing it.
+// Elements present in every version score 1.0.
+//
+// Time complexity: O(N) where N is the total number of elements across all versions.
+func StabilityScores[T comparable](versions []Set[T]) map[T]float64 {
+	counts := countOccurrences(versions)
+	scores := make(map[T]float64, len(counts))
+	for element, count := range counts {
+		scores[element] = float64(count) / float64(len(versions))
+	}
+	return scores
+}
+
+// IncrementalAdditions returns, for every version, how many of its elements never appeared in any earlier version.
+// Elements that were removed and later reintroduced are not counted again.
+//
+// Time complexity: O(N) where N is the total number of elements across all versions.
+func IncrementalAdditions[T comparable](versions []Set[T]) []int {
+	seen := make(HashSet[T])
+	additions := make([]int, len(versions))
+	for i, version := range versions {
+		for element := range version.All() {
+			if !seen.Contains(element) {
+				seen[element] = struct{}{}
+				additions[i]++
+			}
+		}
+	}
+	return additions
+}
@@ -0,0 +1,73 @@
+package goset
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+// configHistory returns versions in which "a" persists throughout, "b" drops out midway,
+// "c" drops out and returns, and "d" appears late.
+func configHistory() []Set[string] {
+	return []Set[string]{
+		NewHashSet("a", "b", "c"),
+		NewHashSet("a", "b", "c"),
+		NewHashSet("a", "b"),
+		NewHashSet("a", "c", "d"),
+		NewHashSet("a", "c", "d"),
+	}
+}
+
+func TestPersistentAcross(t *testing.T) {
+	if got, want := sortedElements(PersistentAcross(configHistory())), []string{"a"}; !slices.Equal(got, want) {
+		t.Errorf("PersistentAcross = %v, want %v", got, want)
+	}
+	if got := PersistentAcross[string](nil); got.Len() != 0 {
+		t.Errorf("PersistentAcross(nil) = %v, want empty", got)
+	}
+}
+
+func TestMaxStableRun(t *testing.T) {
+	versions := configHistory()
+	tests := []struct {
+		element string
+		want    int
+	}{
+		{"a", 5},
+		{"b", 3},
+		{"c", 2},
+		{"d", 2},
+		{"e", 0},
+	}
+	for _, tt := range tests {
+		if got := MaxStableRun(versions, tt.element); got != tt.want {
+			t.Errorf("MaxStableRun(%q) = %d, want %d", tt.element, got, tt.want)
+		}
+	}
+}
+
+func TestStabilityScores(t *testing.T) {
+	versions := []Set[string]{
+		NewHashSet("always", "sometimes"),
+		NewHashSet("always", "once"),
+		NewHashSet("always", "sometimes"),
+		NewHashSet("always"),
+	}
+	want := map[string]float64{"always": 1, "sometimes": 0.5, "once": 0.25}
+	if got := StabilityScores(versions); !maps.Equal(got, want) {
+		t.Errorf("StabilityScores = %v, want %v", got, want)
+	}
+}
+
+func TestIncrementalAdditions(t *testing.T) {
+	versions := []Set[string]{
+		NewHashSet("a", "b"),
+		NewHashSet("b", "c"),
+		NewHashSet("c"),
+		NewHashSet("a", "b", "c"), // a and b return and are not new
+		NewHashSet("a", "d", "e"),
+	}
+	if got, want := IncrementalAdditions(versions), []int{2, 1, 0, 0, 2}; !slices.Equal(got, want) {
+		t.Errorf("IncrementalAdditions = %v, want %v", got, want)
+	}
+}
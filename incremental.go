@@ -0,0 +1,53 @@
+package goset
+
+// IncrementalIntersection maintains the intersection of a stable set with a frequently changing one.
+// Changes to the variable operand are applied through Add and Remove, which update the cached
+// intersection in O(1) instead of recomputing it from scratch.
+//
+// The stable set must not be modified while the IncrementalIntersection is in use.
+//
+// The zero value is not usable - use NewIncrementalIntersection to create instances.
+type IncrementalIntersection[T comparable] struct {
+	stable Set[T]
+	result HashSet[T]
+}
+
+// NewIncrementalIntersection creates a new IncrementalIntersection of the stable set with the initial contents of the variable operand.
+func NewIncrementalIntersection[T comparable](stable Set[T], variable Set[T]) *IncrementalIntersection[T] {
+	intersection := &IncrementalIntersection[T]{
+		stable: stable,
+		result: make(HashSet[T]),
+	}
+	for element := range variable.All() {
+		intersection.Add(element)
+	}
+	return intersection
+}
+
+// Add records that the element was added to the variable operand.
+//
+// Time complexity: O(c) where c is time complexity of the stable set's Contains() method.
+func (intersection *IncrementalIntersection[T]) Add(element T) {
+	if intersection.stable.Contains(element) {
+		intersection.result[element] = struct{}{}
+	}
+}
+
+// Remove records that the element was removed from the variable operand.
+//
+// Time complexity: O(1).
+func (intersection *IncrementalIntersection[T]) Remove(element T) {
+	delete(intersection.result, element)
+}
+
+// Result returns a copy of the current intersection.
+//
+// Time complexity: O(n) where n is the size of the intersection.
+func (intersection *IncrementalIntersection[T]) Result() Set[T] {
+	return intersection.result.Clone()
+}
+
+// Len returns the number of elements in the current intersection.
+func (intersection *IncrementalIntersection[T]) Len() int {
+	return len(intersection.result)
+}
@@ -0,0 +1,46 @@
+package goset
+
+import (
+	"testing"
+)
+
+func TestIncrementalIntersection(t *testing.T) {
+	stable := NewHashSet(1, 2, 3, 4, 5)
+	variable := NewHashSet(4, 5, 6)
+	intersection := NewIncrementalIntersection[int](stable, variable)
+
+	steps := []struct {
+		add     bool
+		element int
+	}{
+		{true, 1},
+		{true, 7},
+		{false, 5},
+		{false, 9},
+		{true, 3},
+		{false, 1},
+		{true, 1},
+	}
+	for i, step := range steps {
+		if step.add {
+			variable.Add(step.element)
+			intersection.Add(step.element)
+		} else {
+			variable.Remove(step.element)
+			intersection.Remove(step.element)
+		}
+		want := stable.Intersection(variable)
+		if got := intersection.Result(); !got.Equals(want) {
+			t.Errorf("step %d: Result = %v, want %v", i, got, want)
+		}
+		if got := intersection.Len(); got != want.Len() {
+			t.Errorf("step %d: Len = %d, want %d", i, got, want.Len())
+		}
+	}
+
+	// The result is a copy and does not alias the cached intersection.
+	intersection.Result().Add(100)
+	if intersection.Len() != stable.Intersection(variable).Len() {
+		t.Error("modifying Result changed the cached intersection")
+	}
+}
@@ -0,0 +1,69 @@
+package goset
+
+// BuildIndex constructs an inverted index mapping every token to the set of IDs of the documents containing it.
+//
+// Time complexity: O(N) where N is the total number of tokens across all documents.
+func BuildIndex[Doc any, Token comparable](docs []Doc, tokens func(Doc) []Token, id func(Doc) int) map[Token]Set[int] {
+	index := make(map[Token]Set[int])
+	for _, doc := range docs {
+		docID := id(doc)
+		for _, token := range tokens(doc) {
+			postings, ok := index[token]
+			if !ok {
+				postings = NewHashSet[int]()
+				index[token] = postings
+			}
+			postings.Add(docID)
+		}
+	}
+	return index
+}
+
+// MergeIndex returns a new index whose value set for every key is the union of
+// that key's value sets in a and b. Keys present in only one index are copied.
+// Neither input index nor its value sets are mutated.
+//
+// Time complexity: O(N) where N is the total size of all value sets.
+func MergeIndex[K comparable, V comparable](a, b map[K]Set[V]) map[K]Set[V] {
+	merged := make(map[K]Set[V], max(len(a), len(b)))
+	for key, values := range a {
+		merged[key] = values.Clone()
+	}
+	for key, values := range b {
+		if existing, ok := merged[key]; ok {
+			existing.Merge(values)
+		} else {
+			merged[key] = values.Clone()
+		}
+	}
+	return merged
+}
+
+// IntersectIndexes answers an AND query over two indexes: for every key present in both,
+// the result holds the intersection of the two value sets. Keys present in only one index are omitted.
+//
+// Time complexity: O(N) where N is the total size of the value sets of shared keys.
+func IntersectIndexes[K comparable, V comparable](a, b map[K]Set[V]) map[K]Set[V] {
+	result := make(map[K]Set[V], min(len(a), len(b)))
+	for key, values := range a {
+		if other, ok := b[key]; ok {
+			result[key] = values.Intersection(other)
+		}
+	}
+	return result
+}
+
+// UnionIndexes answers an OR query over two indexes: for every key present in both,
+// the result holds the union of the two value sets. Keys present in only one index are omitted;
+// use MergeIndex to keep them.
+//
+// Time complexity: O(N) where N is the total size of the value sets of shared keys.
+func UnionIndexes[K comparable, V comparable](a, b map[K]Set[V]) map[K]Set[V] {
+	result := make(map[K]Set[V], min(len(a), len(b)))
+	for key, values := range a {
+		if other, ok := b[key]; ok {
+			result[key] = values.Union(other)
+		}
+	}
+	return result
+}
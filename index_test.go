@@ -0,0 +1,91 @@
+package goset
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+type document struct {
+	id   int
+	text string
+}
+
+func TestBuildIndex(t *testing.T) {
+	docs := []document{
+		{id: 1, text: "red apple"},
+		{id: 2, text: "green apple"},
+		{id: 3, text: "red car"},
+	}
+	index := BuildIndex(docs, func(d document) []string { return strings.Fields(d.text) }, func(d document) int { return d.id })
+
+	want := map[string][]int{
+		"red":   {1, 3},
+		"apple": {1, 2},
+		"green": {2},
+		"car":   {3},
+	}
+	if len(index) != len(want) {
+		t.Errorf("index has %d tokens, want %d", len(index), len(want))
+	}
+	for token, ids := range want {
+		postings, ok := index[token]
+		if !ok {
+			t.Errorf("token %q missing from index", token)
+			continue
+		}
+		if got := sortedElements(postings); !slices.Equal(got, ids) {
+			t.Errorf("postings for %q = %v, want %v", token, got, ids)
+		}
+	}
+}
+
+func TestMergeIndex(t *testing.T) {
+	a := map[string]Set[int]{"shared": NewHashSet(1, 2), "onlyA": NewHashSet(3)}
+	b := map[string]Set[int]{"shared": NewHashSet(2, 4), "onlyB": NewHashSet(5)}
+	merged := MergeIndex(a, b)
+
+	want := map[string][]int{"shared": {1, 2, 4}, "onlyA": {3}, "onlyB": {5}}
+	if len(merged) != len(want) {
+		t.Errorf("merged index has %d keys, want %d", len(merged), len(want))
+	}
+	for key, values := range want {
+		if got := sortedElements(merged[key]); !slices.Equal(got, values) {
+			t.Errorf("merged[%q] = %v, want %v", key, got, values)
+		}
+	}
+	if a["shared"].Len() != 2 || b["shared"].Len() != 2 {
+		t.Error("MergeIndex mutated its inputs")
+	}
+}
+
+func TestIntersectAndUnionIndexes(t *testing.T) {
+	a := map[string]Set[int]{
+		"red":   NewHashSet(1, 2, 3),
+		"apple": NewHashSet(1, 4),
+		"car":   NewHashSet(5),
+	}
+	b := map[string]Set[int]{
+		"red":   NewHashSet(2, 3, 6),
+		"apple": NewHashSet(7),
+		"green": NewHashSet(8),
+	}
+	tests := []struct {
+		name string
+		got  map[string]Set[int]
+		want map[string][]int
+	}{
+		{"IntersectIndexes", IntersectIndexes(a, b), map[string][]int{"red": {2, 3}, "apple": nil}},
+		{"UnionIndexes", UnionIndexes(a, b), map[string][]int{"red": {1, 2, 3, 6}, "apple": {1, 4, 7}}},
+	}
+	for _, tt := range tests {
+		if len(tt.got) != len(tt.want) {
+			t.Errorf("%s returned %d keys, want %d", tt.name, len(tt.got), len(tt.want))
+		}
+		for key, values := range tt.want {
+			if got := sortedElements(tt.got[key]); !slices.Equal(got, values) {
+				t.Errorf("%s[%q] = %v, want %v", tt.name, key, got, values)
+			}
+		}
+	}
+}
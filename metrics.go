@@ -0,0 +1,53 @@
+package goset
+
+// ChangeRatio returns the fraction of elements that changed between two versions of a set,
+// computed as (|added| + |removed|) / |old ∪ new|.
+// It returns 0 for identical sets and 1 for disjoint ones. Two empty sets yield 0.
+//
+// Time complexity: O(n * c) where n is size of the _old_ set and c is time complexity of the _new_ set's Contains() method.
+func ChangeRatio[T comparable](old, new Set[T]) float64 {
+	common := intersectionLen(old, new)
+	union := old.Len() + new.Len() - common
+	if union == 0 {
+		return 0
+	}
+	return float64(union-common) / float64(union)
+}
+
+// WeightedJaccard returns the weighted Jaccard similarity of two sets: the total weight
+// of the intersection divided by the total weight of the union.
+// With a uniform weight function it equals the plain Jaccard index.
+// If the union has zero total weight (including when both sets are empty), it returns 0.
+//
+// Time complexity: O(n * c1 + m * c2) where n and m are the sizes of the sets
+// and c1, c2 are time complexities of b's and a's Contains() methods.
+func WeightedJaccard[T comparable](a, b Set[T], weight func(T) float64) float64 {
+	var intersection, union float64
+	for element := range a.All() {
+		w := weight(element)
+		union += w
+		if b.Contains(element) {
+			intersection += w
+		}
+	}
+	for element := range b.All() {
+		if !a.Contains(element) {
+			union += weight(element)
+		}
+	}
+	if union == 0 {
+		return 0
+	}
+	return intersection / union
+}
+
+// intersectionLen returns |a ∩ b| without allocating the intersection.
+func intersectionLen[T comparable](a, b Set[T]) int {
+	count := 0
+	for element := range a.All() {
+		if b.Contains(element) {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,48 @@
+package goset
+
+import (
+	"testing"
+)
+
+func TestChangeRatio(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new Set[int]
+		want     float64
+	}{
+		{name: "identical", old: NewHashSet(1, 2, 3), new: NewHashSet(3, 2, 1), want: 0},
+		{name: "disjoint", old: NewHashSet(1, 2), new: NewHashSet(3, 4), want: 1},
+		{name: "partial", old: NewHashSet(1, 2, 3), new: NewHashSet(2, 3, 4), want: 0.5},
+		{name: "both empty", old: NewHashSet[int](), new: NewHashSet[int](), want: 0},
+	}
+	for _, tt := range tests {
+		if got := ChangeRatio(tt.old, tt.new); got != tt.want {
+			t.Errorf("%s: ChangeRatio = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestWeightedJaccard(t *testing.T) {
+	a := NewHashSet(1, 2, 3)
+	b := NewHashSet(2, 3, 4, 5)
+
+	uniform := func(int) float64 { return 1 }
+	// Plain Jaccard: |{2, 3}| / |{1, 2, 3, 4, 5}|.
+	if got, want := WeightedJaccard[int](a, b, uniform), 2.0/5.0; got != want {
+		t.Errorf("uniform WeightedJaccard = %v, want %v", got, want)
+	}
+
+	identity := func(x int) float64 { return float64(x) }
+	// (2 + 3) / (1 + 2 + 3 + 4 + 5).
+	if got, want := WeightedJaccard[int](a, b, identity), 5.0/15.0; got != want {
+		t.Errorf("weighted WeightedJaccard = %v, want %v", got, want)
+	}
+
+	zero := func(int) float64 { return 0 }
+	if got := WeightedJaccard[int](a, b, zero); got != 0 {
+		t.Errorf("zero-weight WeightedJaccard = %v, want 0", got)
+	}
+	if got := WeightedJaccard[int](NewHashSet[int](), NewHashSet[int](), uniform); got != 0 {
+		t.Errorf("empty WeightedJaccard = %v, want 0", got)
+	}
+}
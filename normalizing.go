@@ -0,0 +1,39 @@
+package goset
+
+// NormalizingSet is a wrapper that normalizes elements before they reach the underlying Set.
+// Add, Remove, and Contains pass every element through the normalize function first, so elements
+// with the same normalized form (e.g. "Foo" and "foo" under strings.ToLower) are treated as equal.
+// Iteration yields the stored, normalized forms.
+//
+// Operations taking another set (Union, Merge, Equals, ...) are delegated unchanged
+// and do not normalize the other set's elements.
+//
+// The zero value is not usable - use NewNormalizingSet to create instances.
+type NormalizingSet[T comparable] struct {
+	Set[T]
+	normalize func(T) T
+}
+
+// NewNormalizingSet creates a new NormalizingSet wrapping the provided Set.
+// Elements already present in the set are not normalized.
+func NewNormalizingSet[T comparable](set Set[T], normalize func(T) T) *NormalizingSet[T] {
+	return &NormalizingSet[T]{
+		Set:       set,
+		normalize: normalize,
+	}
+}
+
+// Add inserts the normalized element into the set.
+func (set *NormalizingSet[T]) Add(element T) {
+	set.Set.Add(set.normalize(element))
+}
+
+// Remove deletes the normalized element from the set.
+func (set *NormalizingSet[T]) Remove(element T) {
+	set.Set.Remove(set.normalize(element))
+}
+
+// Contains reports whether the normalized element exists in the set.
+func (set *NormalizingSet[T]) Contains(element T) bool {
+	return set.Set.Contains(set.normalize(element))
+}
@@ -0,0 +1,29 @@
+package goset
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestNormalizingSet(t *testing.T) {
+	set := NewNormalizingSet[string](NewHashSet[string](), strings.ToLower)
+	set.Add("Foo")
+	set.Add("FOO")
+	set.Add("bar")
+
+	if set.Len() != 2 {
+		t.Errorf("Len = %d, want 2", set.Len())
+	}
+	if !set.Contains("foo") || !set.Contains("fOo") || !set.Contains("BAR") {
+		t.Error("Contains is not case-insensitive")
+	}
+	if got, want := slices.Sorted(set.All()), []string{"bar", "foo"}; !slices.Equal(got, want) {
+		t.Errorf("elements = %v, want normalized %v", got, want)
+	}
+
+	set.Remove("BaR")
+	if set.Contains("bar") {
+		t.Error("Remove did not normalize its argument")
+	}
+}
@@ -0,0 +1,29 @@
+package goset
+
+import (
+	"sync"
+)
+
+// Pool is a free list of HashSets backed by sync.Pool.
+// It reuses the backing maps of discarded sets to reduce allocations and GC pressure
+// on hot paths that create many short-lived sets.
+//
+// The zero value is ready to use. A Pool is safe for concurrent use.
+type Pool[T comparable] struct {
+	pool sync.Pool
+}
+
+// Get returns an empty HashSet, reusing a previously released one when available.
+func (p *Pool[T]) Get() HashSet[T] {
+	if set, ok := p.pool.Get().(HashSet[T]); ok {
+		return set
+	}
+	return make(HashSet[T])
+}
+
+// Put clears the set and returns it to the pool.
+// The set must not be used after calling Put.
+func (p *Pool[T]) Put(s HashSet[T]) {
+	clear(s)
+	p.pool.Put(s)
+}
@@ -0,0 +1,60 @@
+package goset
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestPoolGetReturnsEmptySet(t *testing.T) {
+	var pool Pool[int]
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 1000 {
+				set := pool.Get()
+				if len(set) != 0 {
+					t.Errorf("Get returned a set with %d elements, want 0", len(set))
+					return
+				}
+				set[g] = struct{}{}
+				set[i] = struct{}{}
+				pool.Put(set)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestPoolPutClears(t *testing.T) {
+	var pool Pool[string]
+	set := pool.Get()
+	set["a"] = struct{}{}
+	pool.Put(set)
+	if len(set) != 0 {
+		t.Errorf("Put left %d elements in the set, want 0", len(set))
+	}
+}
+
+func BenchmarkPool(b *testing.B) {
+	var pool Pool[int]
+	b.ReportAllocs()
+	for range b.N {
+		set := pool.Get()
+		for i := range 16 {
+			set[i] = struct{}{}
+		}
+		pool.Put(set)
+	}
+}
+
+func BenchmarkNewHashSet(b *testing.B) {
+	b.ReportAllocs()
+	for range b.N {
+		set := NewHashSet[int]()
+		for i := range 16 {
+			set.Add(i)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package goset
+
+import (
+	"slices"
+)
+
+// PtrSet is a HashSet of pointers with identity semantics and readable output.
+// Membership is decided by pointer identity, so two distinct pointers to equal values are different elements.
+// Instead of printing addresses, String and Formatted render elements through a formatter.
+//
+// The zero value is not usable - use NewPtrSet to create instances.
+type PtrSet[T any] struct {
+	HashSet[*T]
+	format func(*T) string
+}
+
+// NewPtrSet creates a new PtrSet with optional initial elements, rendering elements with the format function.
+// The format function is never called with a nil pointer; nil elements are rendered as "<nil>".
+func NewPtrSet[T any](format func(*T) string, elements ...*T) *PtrSet[T] {
+	return &PtrSet[T]{
+		HashSet: *NewHashSet(elements...),
+		format:  format,
+	}
+}
+
+// Formatted returns the formatted elements in ascending order.
+func (set *PtrSet[T]) Formatted() []string {
+	formatted := make([]string, 0, len(set.HashSet))
+	for item := range set.HashSet {
+		formatted = append(formatted, set.formatElement(item))
+	}
+	slices.Sort(formatted)
+	return formatted
+}
+
+// String returns a human-readable representation in the format "Set{e1, e2, ...}" using the formatter.
+func (set *PtrSet[T]) String() string {
+	return set.HashSet.StringFunc(set.formatElement)
+}
+
+// formatElement renders a single element, guarding against nil pointers.
+func (set *PtrSet[T]) formatElement(element *T) string {
+	if element == nil {
+		return "<nil>"
+	}
+	return set.format(element)
+}
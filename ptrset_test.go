@@ -0,0 +1,29 @@
+package goset
+
+import (
+	"slices"
+	"testing"
+)
+
+type node struct {
+	name string
+}
+
+func TestPtrSet(t *testing.T) {
+	a, b := &node{"a"}, &node{"b"}
+	twin := &node{"a"}
+	s := NewPtrSet(func(n *node) string { return n.name }, b, a, nil)
+
+	if !s.Contains(a) || !s.Contains(b) {
+		t.Error("PtrSet does not contain its own pointers")
+	}
+	if s.Contains(twin) {
+		t.Error("PtrSet contains a distinct pointer to an equal value, want identity semantics")
+	}
+	if got, want := s.Formatted(), []string{"<nil>", "a", "b"}; !slices.Equal(got, want) {
+		t.Errorf("Formatted = %v, want %v", got, want)
+	}
+	if got, want := s.String(), "Set{<nil>, a, b}"; got != want {
+		t.Errorf("String = %q, want %q", got, want)
+	}
+}
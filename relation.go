@@ -0,0 +1,71 @@
+package goset
+
+// IsFunctionalDependency reports whether the key of every element uniquely determines its value,
+// i.e. no two elements share a key while differing in value.
+//
+// Time complexity: O(n) where n is the size of the set.
+func IsFunctionalDependency[T comparable, K comparable, V comparable](s Set[T], key func(T) K, value func(T) V) bool {
+	seen := make(map[K]V, s.Len())
+	for element := range s.All() {
+		k, v := key(element), value(element)
+		if existing, ok := seen[k]; ok && existing != v {
+			return false
+		}
+		seen[k] = v
+	}
+	return true
+}
+
+// Quotient partitions the set into equivalence classes keyed by classOf.
+// Every element belongs to exactly one class, so the union of all classes equals the original set.
+//
+// Time complexity: O(n) where n is the size of the set.
+func Quotient[T comparable, K comparable](s Set[T], classOf func(T) K) map[K]Set[T] {
+	classes := make(map[K]Set[T])
+	for element := range s.All() {
+		key := classOf(element)
+		class, ok := classes[key]
+		if !ok {
+			class = NewHashSet[T]()
+			classes[key] = class
+		}
+		class.Add(element)
+	}
+	return classes
+}
+
+// InvertToSets inverts a map, grouping keys by value: every value maps to the set of keys that had it.
+//
+// Time complexity: O(n) where n is the size of the map.
+func InvertToSets[K comparable, V comparable](m map[K]V) map[V]Set[K] {
+	inverted := make(map[V]Set[K])
+	for key, value := range m {
+		keys, ok := inverted[value]
+		if !ok {
+			keys = NewHashSet[K]()
+			inverted[value] = keys
+		}
+		keys.Add(key)
+	}
+	return inverted
+}
+
+// Join performs an inner join of two sets on a shared key: for every pair of elements
+// with equal keys it adds combine(a, b) to the result. It is implemented as a hash join
+// that indexes b by key and probes it with the elements of a.
+//
+// Time complexity: O(n + m + p) where n and m are the sizes of the sets and p is the number of matching pairs.
+func Join[A comparable, B comparable, K comparable, R comparable](a Set[A], b Set[B], keyA func(A) K, keyB func(B) K, combine func(A, B) R) Set[R] {
+	byKey := make(map[K][]B, b.Len())
+	for element := range b.All() {
+		key := keyB(element)
+		byKey[key] = append(byKey[key], element)
+	}
+	newset := NewHashSet[R]()
+	for left := range a.All() {
+		for _, right := range byKey[keyA(left)] {
+			newset.Add(combine(left, right))
+		}
+	}
+	return newset
+}
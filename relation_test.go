@@ -0,0 +1,96 @@
+package goset
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+type record struct {
+	id   int
+	name string
+}
+
+func TestIsFunctionalDependency(t *testing.T) {
+	key := func(r record) int { return r.id }
+	value := func(r record) string { return r.name }
+	tests := []struct {
+		name    string
+		records []record
+		want    bool
+	}{
+		{"empty", nil, true},
+		{"distinct keys", []record{{1, "a"}, {2, "b"}, {3, "a"}}, true},
+		{"violated", []record{{1, "a"}, {1, "b"}, {2, "c"}}, false},
+	}
+	for _, tt := range tests {
+		s := NewHashSet(tt.records...)
+		if got := IsFunctionalDependency(s, key, value); got != tt.want {
+			t.Errorf("%s: IsFunctionalDependency = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestQuotient(t *testing.T) {
+	s := NewHashSet(0, 1, 2, 3, 4, 5, 6, 7)
+	classes := Quotient[int](s, func(n int) int { return n % 3 })
+
+	want := map[int][]int{0: {0, 3, 6}, 1: {1, 4, 7}, 2: {2, 5}}
+	if len(classes) != len(want) {
+		t.Fatalf("Quotient produced %d classes, want %d", len(classes), len(want))
+	}
+	union := NewHashSet[int]()
+	for key, elements := range want {
+		if got := sortedElements(classes[key]); !slices.Equal(got, elements) {
+			t.Errorf("Quotient class %d = %v, want %v", key, got, elements)
+		}
+		union.Merge(classes[key])
+	}
+	if !union.Equals(s) {
+		t.Errorf("union of classes = %v, want %v", union, s)
+	}
+}
+
+func TestInvertToSets(t *testing.T) {
+	owners := map[string]string{
+		"main.go":   "alice",
+		"util.go":   "bob",
+		"server.go": "alice",
+		"README":    "carol",
+	}
+	inverted := InvertToSets(owners)
+	want := map[string][]string{
+		"alice": {"main.go", "server.go"},
+		"bob":   {"util.go"},
+		"carol": {"README"},
+	}
+	if len(inverted) != len(want) {
+		t.Fatalf("InvertToSets returned %d values, want %d", len(inverted), len(want))
+	}
+	for value, keys := range want {
+		if got := sortedElements(inverted[value]); !slices.Equal(got, keys) {
+			t.Errorf("InvertToSets[%q] = %v, want %v", value, got, keys)
+		}
+	}
+}
+
+func TestJoin(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	type order struct {
+		id, userID int
+	}
+	users := NewHashSet(user{1, "alice"}, user{2, "bob"}, user{3, "carol"})
+	orders := NewHashSet(order{10, 1}, order{11, 1}, order{12, 2}, order{13, 4})
+
+	joined := Join(Set[user](users), Set[order](orders),
+		func(u user) int { return u.id },
+		func(o order) int { return o.userID },
+		func(u user, o order) string { return fmt.Sprintf("%s#%d", u.name, o.id) },
+	)
+	if got, want := sortedElements(joined), []string{"alice#10", "alice#11", "bob#12"}; !slices.Equal(got, want) {
+		t.Errorf("Join = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,217 @@
+package goset
+
+import (
+	"cmp"
+	"container/heap"
+	"iter"
+	"slices"
+)
+
+// UnionSeq returns a lazy iterator over the union of two sets.
+// Elements of a are yielded first, followed by elements of b not present in a.
+// Neither operand is mutated, and both are accessed only through the Set interface.
+//
+// Time complexity: O(n + m * c) where n and m are the sizes of the sets and c is time complexity of a's Contains() method.
+func UnionSeq[T comparable](a, b Set[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for element := range a.All() {
+			if !yield(element) {
+				return
+			}
+		}
+		for element := range b.All() {
+			if a.Contains(element) {
+				continue
+			}
+			if !yield(element) {
+				return
+			}
+		}
+	}
+}
+
+// UnionGeneric returns a new set containing all elements present in either set.
+// Unlike the Union method, it works for any combination of Set implementations.
+//
+// Time complexity: O(n + m * c) where n and m are the sizes of the sets and c is time complexity of a's Contains() method.
+func UnionGeneric[T comparable](a, b Set[T]) Set[T] {
+	newset := make(HashSet[T], a.Len()+b.Len())
+	for element := range UnionSeq(a, b) {
+		newset[element] = struct{}{}
+	}
+	return &newset
+}
+
+// UnionSameType returns a new set containing all elements present in either set,
+// with the same concrete type as a. It clones a and merges b into the clone,
+// so ordered or otherwise specialized implementations keep their behavior in the result.
+//
+// Time complexity: O(n + m * c) where n and m are the sizes of the sets and c is time complexity of a's Add() method.
+func UnionSameType[T comparable](a Set[T], b Set[T]) Set[T] {
+	newset := a.Clone()
+	newset.Merge(b)
+	return newset
+}
+
+// UnionMinus returns a new set containing elements of a or b that are not in c, i.e. (A ∪ B) \ C,
+// in a single fused pass over a and b without building the intermediate union.
+//
+// Time complexity: O((n + m) * t) where n and m are the sizes of a and b and t is time complexity of c's Contains() method.
+func UnionMinus[T comparable](a, b, c Set[T]) Set[T] {
+	newset := make(HashSet[T], a.Len()+b.Len())
+	for _, set := range []Set[T]{a, b} {
+		for element := range set.All() {
+			if !c.Contains(element) {
+				newset[element] = struct{}{}
+			}
+		}
+	}
+	return &newset
+}
+
+// ScanUnion returns an iterator over the running union of a sequence of sets.
+// After folding in each input set it yields the cumulative union so far.
+// Every yielded set is an independent snapshot that is not affected by later inputs.
+//
+// Time complexity: O(k * N) where k is the number of input sets and N is the size of the final union.
+func ScanUnion[T comparable](sets iter.Seq[Set[T]]) iter.Seq[Set[T]] {
+	return func(yield func(Set[T]) bool) {
+		acc := NewHashSet[T]()
+		for set := range sets {
+			acc.Merge(set)
+			if !yield(acc.Clone()) {
+				return
+			}
+		}
+	}
+}
+
+// LazyComplement returns an iterator over the elements of the universe that are not in the set.
+// The universe is consumed lazily and never materialized.
+//
+// Time complexity: O(u * c) where u is the length of the universe and c is time complexity of the set's Contains() method.
+func LazyComplement[T comparable](universe iter.Seq[T], s Set[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for element := range universe {
+			if s.Contains(element) {
+				continue
+			}
+			if !yield(element) {
+				return
+			}
+		}
+	}
+}
+
+// MergeSorted returns an iterator over the union of the given sets in ascending order,
+// without duplicates. It performs a k-way merge of each set's sorted elements using a min-heap.
+//
+// Time complexity: O(N log N) where N is the total number of elements across all sets,
+// dominated by sorting each input; the merge itself is O(N log k) for k sets.
+func MergeSorted[T cmp.Ordered](sets ...Set[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		runs := make(sortedRuns[T], 0, len(sets))
+		for _, set := range sets {
+			if run := slices.Sorted(set.All()); len(run) > 0 {
+				runs = append(runs, run)
+			}
+		}
+		heap.Init(&runs)
+		var last T
+		started := false
+		for len(runs) > 0 {
+			element := runs[0][0]
+			if runs[0] = runs[0][1:]; len(runs[0]) == 0 {
+				heap.Pop(&runs)
+			} else {
+				heap.Fix(&runs, 0)
+			}
+			if started && element == last {
+				continue
+			}
+			last, started = element, true
+			if !yield(element) {
+				return
+			}
+		}
+	}
+}
+
+// sortedRuns is a min-heap of non-empty sorted slices ordered by their first element.
+type sortedRuns[T cmp.Ordered] [][]T
+
+func (runs sortedRuns[T]) Len() int           { return len(runs) }
+func (runs sortedRuns[T]) Less(i, j int) bool { return runs[i][0] < runs[j][0] }
+func (runs sortedRuns[T]) Swap(i, j int)      { runs[i], runs[j] = runs[j], runs[i] }
+func (runs *sortedRuns[T]) Push(x any)        { *runs = append(*runs, x.([]T)) }
+func (runs *sortedRuns[T]) Pop() any {
+	old := *runs
+	run := old[len(old)-1]
+	*runs = old[:len(old)-1]
+	return run
+}
+
+// Combinations returns an iterator over every k-element subset of s, each yielded as a new slice.
+// The order of subsets and of elements within a subset is undefined.
+// For k = 0 it yields a single empty slice; for k < 0 or k greater than the size of s it yields nothing.
+//
+// Time complexity: O(C(n, k) * k) where n is the size of the set.
+func Combinations[T comparable](s Set[T], k int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		elements := s.Elements()
+		n := len(elements)
+		if k < 0 || k > n {
+			return
+		}
+		indices := make([]int, k)
+		for i := range indices {
+			indices[i] = i
+		}
+		for {
+			combination := make([]T, k)
+			for i, index := range indices {
+				combination[i] = elements[index]
+			}
+			if !yield(combination) {
+				return
+			}
+			// Advance the rightmost index that can still move.
+			i := k - 1
+			for i >= 0 && indices[i] == n-k+i {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indices[i]++
+			for j := i + 1; j < k; j++ {
+				indices[j] = indices[j-1] + 1
+			}
+		}
+	}
+}
+
+// PowerSetSeq returns an iterator over all 2^n subsets of s, constructing each subset on demand
+// from a bitmask so that only one subset is held at a time.
+// It panics if the set has 64 or more elements.
+//
+// Time complexity: O(2^n * n) where n is the size of the set.
+func PowerSetSeq[T comparable](s Set[T]) iter.Seq[Set[T]] {
+	return func(yield func(Set[T]) bool) {
+		elements := s.Elements()
+		if len(elements) >= 64 {
+			panic("goset: power set of 64 or more elements")
+		}
+		for mask := uint64(0); mask < 1<<len(elements); mask++ {
+			subset := NewHashSet[T]()
+			for i, element := range elements {
+				if mask&(1<<i) != 0 {
+					subset.Add(element)
+				}
+			}
+			if !yield(subset) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package goset
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestUnionSeqAndUnionGeneric(t *testing.T) {
+	a := NewHashSet(1, 2, 3)
+	b := NewSyncSet[int](NewHashSet(3, 4, 5))
+	want := []int{1, 2, 3, 4, 5}
+
+	lazy := slices.Sorted(UnionSeq[int](a, b))
+	if !slices.Equal(lazy, want) {
+		t.Errorf("UnionSeq = %v, want %v", lazy, want)
+	}
+	eager := UnionGeneric[int](b, a)
+	if got := sortedElements(eager); !slices.Equal(got, want) {
+		t.Errorf("UnionGeneric = %v, want %v", got, want)
+	}
+	if a.Len() != 3 || b.Len() != 3 {
+		t.Errorf("operands were mutated: a = %v, b = %v", a, b)
+	}
+}
+
+func TestUnionSeqStopsEarly(t *testing.T) {
+	count := 0
+	for range UnionSeq[int](NewHashSet(1, 2, 3), NewHashSet(4, 5)) {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	if count != 2 {
+		t.Errorf("iterated %d elements after break, want 2", count)
+	}
+}
+
+func TestScanUnion(t *testing.T) {
+	inputs := []Set[int]{NewHashSet(1), NewHashSet(2, 3), NewHashSet(1, 4)}
+	want := [][]int{{1}, {1, 2, 3}, {1, 2, 3, 4}}
+
+	var snapshots []Set[int]
+	for snapshot := range ScanUnion(slices.Values(inputs)) {
+		snapshots = append(snapshots, snapshot)
+	}
+	if len(snapshots) != len(want) {
+		t.Fatalf("ScanUnion yielded %d snapshots, want %d", len(snapshots), len(want))
+	}
+	// Checking after the loop proves that later inputs did not change earlier snapshots.
+	for i, snapshot := range snapshots {
+		if got := sortedElements(snapshot); !slices.Equal(got, want[i]) {
+			t.Errorf("snapshot %d = %v, want %v", i, got, want[i])
+		}
+	}
+}
+
+func TestLazyComplement(t *testing.T) {
+	universe := func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	exclude := NewHashSet(1, 3, 4)
+
+	var got []int
+	for element := range LazyComplement[int](universe, exclude) {
+		if element >= 7 {
+			break
+		}
+		got = append(got, element)
+	}
+	if want := []int{0, 2, 5, 6}; !slices.Equal(got, want) {
+		t.Errorf("LazyComplement = %v, want %v", got, want)
+	}
+}
+
+func TestMergeSorted(t *testing.T) {
+	a := NewHashSet(5, 1, 9)
+	b := NewHashSet(2, 5, 8)
+	c := NewHashSet(9, 3, 1, 10)
+	got := slices.Collect(MergeSorted[int](a, b, NewHashSet[int](), c))
+	if want := []int{1, 2, 3, 5, 8, 9, 10}; !slices.Equal(got, want) {
+		t.Errorf("MergeSorted = %v, want %v", got, want)
+	}
+
+	var first []int
+	for element := range MergeSorted[int](a, b, c) {
+		if first = append(first, element); len(first) == 2 {
+			break
+		}
+	}
+	if want := []int{1, 2}; !slices.Equal(first, want) {
+		t.Errorf("MergeSorted stopped early = %v, want %v", first, want)
+	}
+}
+
+// orderedSet stands in for an ordered implementation: its Elements are sorted and Clone keeps its type.
+type orderedSet struct {
+	Set[int]
+}
+
+func (set *orderedSet) Elements() []int {
+	return slices.Sorted(set.Set.All())
+}
+
+func (set *orderedSet) Clone() Set[int] {
+	return &orderedSet{Set: set.Set.Clone()}
+}
+
+func TestUnionSameType(t *testing.T) {
+	a := &orderedSet{Set: NewHashSet(5, 1, 3)}
+	b := NewHashSet(4, 2, 3)
+
+	union := UnionSameType[int](a, b)
+	ordered, ok := union.(*orderedSet)
+	if !ok {
+		t.Fatalf("UnionSameType returned %T, want *orderedSet", union)
+	}
+	if got, want := ordered.Elements(), []int{1, 2, 3, 4, 5}; !slices.Equal(got, want) {
+		t.Errorf("UnionSameType elements = %v, want %v", got, want)
+	}
+	if got, want := a.Elements(), []int{1, 3, 5}; !slices.Equal(got, want) {
+		t.Errorf("UnionSameType modified its first operand: %v, want %v", got, want)
+	}
+}
+
+func TestUnionMinus(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b, c []int
+	}{
+		{"overlapping", []int{1, 2, 3}, []int{3, 4, 5}, []int{2, 5, 9}},
+		{"c removes all", []int{1, 2}, []int{3}, []int{1, 2, 3}},
+		{"empty c", []int{1}, []int{1, 2}, nil},
+		{"empty a and b", nil, nil, []int{1}},
+	}
+	for _, tt := range tests {
+		a, b, c := NewHashSet(tt.a...), NewHashSet(tt.b...), NewHashSet(tt.c...)
+		want := a.Union(b).Difference(c)
+		if got := UnionMinus[int](a, b, c); !got.Equals(want) {
+			t.Errorf("%s: UnionMinus = %v, want %v", tt.name, got, want)
+		}
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	s := NewHashSet(1, 2, 3, 4)
+	var got [][]int
+	for combination := range Combinations[int](s, 2) {
+		slices.Sort(combination)
+		got = append(got, combination)
+	}
+	slices.SortFunc(got, slices.Compare)
+	want := [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("Combinations(2) = %v, want %v", got, want)
+	}
+
+	counts := []struct {
+		k, want int
+	}{
+		{k: -1, want: 0},
+		{k: 0, want: 1},
+		{k: 1, want: 4},
+		{k: 4, want: 1},
+		{k: 5, want: 0},
+	}
+	for _, tt := range counts {
+		n := 0
+		for combination := range Combinations[int](s, tt.k) {
+			if len(combination) != tt.k {
+				t.Errorf("Combinations(%d) yielded %v", tt.k, combination)
+			}
+			n++
+		}
+		if n != tt.want {
+			t.Errorf("Combinations(%d) yielded %d subsets, want %d", tt.k, n, tt.want)
+		}
+	}
+}
+
+func TestPowerSetSeq(t *testing.T) {
+	for n := range 6 {
+		s := NewHashSet[int]()
+		for i := range n {
+			s.Add(i)
+		}
+		seen := make(map[string]bool)
+		for subset := range PowerSetSeq[int](s) {
+			if !subset.IsSubset(s) {
+				t.Errorf("n=%d: PowerSetSeq yielded %v, not a subset of %v", n, subset, s)
+			}
+			seen[CanonicalKey(subset)] = true
+		}
+		if want := 1 << n; len(seen) != want {
+			t.Errorf("n=%d: PowerSetSeq yielded %d distinct subsets, want %d", n, len(seen), want)
+		}
+	}
+
+	yielded := 0
+	for range PowerSetSeq[int](NewHashSet(1, 2, 3, 4)) {
+		if yielded++; yielded == 3 {
+			break
+		}
+	}
+	if yielded != 3 {
+		t.Errorf("PowerSetSeq yielded %d subsets before stopping, want 3", yielded)
+	}
+}
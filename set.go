@@ -70,6 +70,28 @@ type Set[T comparable] interface {
 	// Len returns the number of elements in the set.
 	Len() int
 
+	// IsEmpty reports whether the set contains no elements.
+	IsEmpty() bool
+
 	// String returns a human-readable representation in the format "Set{e1, e2, ...}".
 	fmt.Stringer
 }
+
+// SafeContains reports whether the element exists in the set.
+// Unlike calling Contains directly, it returns false for a nil set instead of panicking.
+// Only a nil interface value counts as a nil set: a nil *HashSet stored in s is not nil and still panics.
+func SafeContains[T comparable](s Set[T], element T) bool {
+	if s == nil {
+		return false
+	}
+	return s.Contains(element)
+}
+
+// SafeLen returns the number of elements in the set, or 0 for a nil set.
+// As with SafeContains, a nil *HashSet stored in s is not a nil set and still panics.
+func SafeLen[T comparable](s Set[T]) int {
+	if s == nil {
+		return 0
+	}
+	return s.Len()
+}
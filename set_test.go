@@ -0,0 +1,66 @@
+package goset
+
+import (
+	"testing"
+)
+
+func TestSafeContainsAndSafeLen(t *testing.T) {
+	var nilSet Set[int]
+	if SafeContains(nilSet, 1) {
+		t.Error("SafeContains(nil, 1) = true, want false")
+	}
+	if got := SafeLen(nilSet); got != 0 {
+		t.Errorf("SafeLen(nil) = %d, want 0", got)
+	}
+
+	set := Set[int](NewHashSet(1, 2))
+	if !SafeContains(set, 1) || SafeContains(set, 3) {
+		t.Error("SafeContains does not delegate to a non-nil set")
+	}
+	if got := SafeLen(set); got != 2 {
+		t.Errorf("SafeLen = %d, want 2", got)
+	}
+}
+
+func TestSafeContainsTypedNil(t *testing.T) {
+	// A nil pointer wrapped in the interface is not a nil set, so the calls reach the value methods and panic.
+	var ptr *HashSet[int]
+	var set Set[int] = ptr
+	for name, call := range map[string]func(){
+		"SafeContains": func() { SafeContains(set, 1) },
+		"SafeLen":      func() { SafeLen(set) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s with a typed nil *HashSet did not panic", name)
+				}
+			}()
+			call()
+		}()
+	}
+}
+
+func TestIsEmpty(t *testing.T) {
+	tests := []struct {
+		name  string
+		empty Set[int]
+		full  Set[int]
+	}{
+		{"HashSet", NewHashSet[int](), NewHashSet(1)},
+		{"SyncSet", NewSyncSet[int](NewHashSet[int]()), NewSyncSet[int](NewHashSet(1))},
+		{"CachedSortedSet", NewCachedSortedSet[int](NewHashSet[int]()), NewCachedSortedSet[int](NewHashSet(1))},
+	}
+	for _, tt := range tests {
+		if !tt.empty.IsEmpty() {
+			t.Errorf("%s: IsEmpty on empty set = false, want true", tt.name)
+		}
+		if tt.full.IsEmpty() {
+			t.Errorf("%s: IsEmpty on non-empty set = true, want false", tt.name)
+		}
+		tt.full.Remove(1)
+		if !tt.full.IsEmpty() {
+			t.Errorf("%s: IsEmpty after removing the last element = false, want true", tt.name)
+		}
+	}
+}
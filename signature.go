@@ -0,0 +1,61 @@
+package goset
+
+import (
+	"cmp"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strings"
+)
+
+// Signature returns a 64-bit FNV-1a fingerprint of the set contents, suitable as a memoization key.
+// Elements are hashed in sorted order, so equal sets always share a signature regardless of
+// insertion order or implementation. Negative zero is hashed as zero, since the two are the same element.
+//
+// Different sets usually have different signatures, but collisions are possible:
+// callers must fall back to Equals when two signatures match.
+//
+// Time complexity: O(n log n) where n is the size of the set.
+func Signature[T cmp.Ordered](s Set[T]) uint64 {
+	hash := fnv.New64a()
+	var length [binary.MaxVarintLen64]byte
+	for _, element := range slices.Sorted(s.All()) {
+		text := fmt.Sprint(normalizeZero(element))
+		hash.Write(length[:binary.PutUvarint(length[:], uint64(len(text)))])
+		hash.Write([]byte(text))
+	}
+	return hash.Sum64()
+}
+
+// normalizeZero maps negative zero to positive zero, which compare equal but format differently.
+// Values of other types are returned unchanged.
+func normalizeZero[T cmp.Ordered](element T) T {
+	var zero T
+	if element == zero {
+		return zero
+	}
+	return element
+}
+
+// CanonicalKey returns a string that identifies the set contents exactly, suitable for deduplicating sets.
+// Elements are formatted with %v, sorted, and joined with commas. Backslashes and commas inside
+// elements are escaped as `\\` and `\,`, and an empty element is written as `\e`,
+// so equal sets produce identical keys and unequal sets produce different keys.
+// Negative zero is written as zero, since the two are the same element.
+//
+// Time complexity: O(n log n) where n is the size of the set.
+func CanonicalKey[T cmp.Ordered](s Set[T]) string {
+	sorted := slices.Sorted(s.All())
+	tokens := make([]string, len(sorted))
+	for i, element := range sorted {
+		tokens[i] = keyEscaper.Replace(fmt.Sprint(normalizeZero(element)))
+		if tokens[i] == "" {
+			tokens[i] = `\e`
+		}
+	}
+	return strings.Join(tokens, ",")
+}
+
+// keyEscaper escapes the characters that are significant in a CanonicalKey.
+var keyEscaper = strings.NewReplacer(`\`, `\\`, `,`, `\,`)
@@ -0,0 +1,65 @@
+package goset
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSignature(t *testing.T) {
+	a := NewHashSet("x", "y", "z")
+	b := NewHashSet("z", "x", "y")
+	if Signature[string](a) != Signature[string](b) {
+		t.Error("equal sets have different signatures")
+	}
+
+	others := []Set[string]{
+		NewHashSet("x", "y"),
+		NewHashSet("x", "y", "w"),
+		NewHashSet("xy", "z"),
+		NewHashSet[string](),
+	}
+	for _, other := range others {
+		if Signature[string](a) == Signature[string](other) {
+			t.Errorf("Signature(%v) == Signature(%v), want different", a, other)
+		}
+	}
+}
+
+func TestSignatureNegativeZero(t *testing.T) {
+	a, b := NewHashSet(0.0, 1.5), NewHashSet(math.Copysign(0, -1), 1.5)
+	if !a.Equals(b) {
+		t.Fatalf("%v and %v are not equal", a, b)
+	}
+	if Signature[float64](a) != Signature[float64](b) {
+		t.Errorf("sets with 0 and -0 have different signatures")
+	}
+}
+
+func TestCanonicalKey(t *testing.T) {
+	if a, b := CanonicalKey[string](NewHashSet("b,c", "a")), CanonicalKey[string](NewHashSet("a", "b,c")); a != b {
+		t.Errorf("equal sets have keys %q and %q", a, b)
+	}
+	if got, want := CanonicalKey[int](NewHashSet(10, 2, 1)), "1,2,10"; got != want {
+		t.Errorf("CanonicalKey of ints = %q, want %q", got, want)
+	}
+
+	if a, b := CanonicalKey[float64](NewHashSet(0.0)), CanonicalKey[float64](NewHashSet(math.Copysign(0, -1))); a != b {
+		t.Errorf("sets with 0 and -0 have keys %q and %q", a, b)
+	}
+
+	// Each pair is unequal but would collide without escaping.
+	pairs := []struct {
+		a, b Set[string]
+	}{
+		{NewHashSet("a,b"), NewHashSet("a", "b")},
+		{NewHashSet(""), NewHashSet[string]()},
+		{NewHashSet("", "a"), NewHashSet(",a")},
+		{NewHashSet(`a\`, "b"), NewHashSet(`a\,b`)},
+		{NewHashSet(`\e`), NewHashSet("")},
+	}
+	for _, tt := range pairs {
+		if CanonicalKey(tt.a) == CanonicalKey(tt.b) {
+			t.Errorf("CanonicalKey(%v) == CanonicalKey(%v) == %q, want different", tt.a, tt.b, CanonicalKey(tt.a))
+		}
+	}
+}
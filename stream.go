@@ -0,0 +1,161 @@
+package goset
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// DistinctWindow counts distinct elements among the most recent items of a stream.
+// It keeps a FIFO of the last N items together with their frequencies.
+//
+// The zero value is not usable - use WindowedDistinct to create instances.
+type DistinctWindow[T comparable] struct {
+	items  []T
+	next   int
+	counts map[T]int
+}
+
+// WindowedDistinct creates a DistinctWindow over the last window items of a stream.
+// It panics if window is not positive.
+func WindowedDistinct[T comparable](window int) *DistinctWindow[T] {
+	if window <= 0 {
+		panic("goset: non-positive window size")
+	}
+	return &DistinctWindow[T]{
+		items:  make([]T, 0, window),
+		counts: make(map[T]int),
+	}
+}
+
+// Push appends the element to the stream, evicting the oldest item once the window is full.
+//
+// Time complexity: O(1).
+func (w *DistinctWindow[T]) Push(element T) {
+	if len(w.items) < cap(w.items) {
+		w.items = append(w.items, element)
+	} else {
+		evicted := w.items[w.next]
+		if w.counts[evicted]--; w.counts[evicted] == 0 {
+			delete(w.counts, evicted)
+		}
+		w.items[w.next] = element
+		w.next = (w.next + 1) % len(w.items)
+	}
+	w.counts[element]++
+}
+
+// Count returns the number of distinct elements in the current window.
+//
+// Time complexity: O(1).
+func (w *DistinctWindow[T]) Count() int {
+	return len(w.counts)
+}
+
+// Counted pairs an element with its occurrence count.
+type Counted[T comparable] struct {
+	Element T
+	Count   int
+}
+
+// TopK tracks the approximately most frequent elements of a stream using the Space-Saving algorithm.
+// It monitors at most k elements; when a new element arrives and all slots are taken,
+// it replaces the least frequent one and inherits its count, so counts may be overestimated
+// by at most the count of the replaced element. Any element occurring more than N/k times
+// in a stream of N items is guaranteed to be tracked.
+//
+// The zero value is not usable - use NewTopK to create instances.
+type TopK[T comparable] struct {
+	k      int
+	counts map[T]int
+}
+
+// NewTopK creates a new TopK tracking at most k elements.
+// It panics if k is not positive.
+func NewTopK[T comparable](k int) *TopK[T] {
+	if k <= 0 {
+		panic("goset: non-positive k")
+	}
+	return &TopK[T]{
+		k:      k,
+		counts: make(map[T]int, k),
+	}
+}
+
+// Add records an occurrence of the element.
+//
+// Time complexity: O(1) if the element is tracked or a slot is free, O(k) otherwise.
+func (top *TopK[T]) Add(element T) {
+	if _, ok := top.counts[element]; ok || len(top.counts) < top.k {
+		top.counts[element]++
+		return
+	}
+	var victim T
+	minCount := -1
+	for candidate, count := range top.counts {
+		if minCount < 0 || count < minCount {
+			victim, minCount = candidate, count
+		}
+	}
+	delete(top.counts, victim)
+	top.counts[element] = minCount + 1
+}
+
+// Top returns the tracked elements with their estimated counts, sorted by descending count.
+//
+// Time complexity: O(k log k).
+func (top *TopK[T]) Top() []Counted[T] {
+	result := make([]Counted[T], 0, len(top.counts))
+	for element, count := range top.counts {
+		result = append(result, Counted[T]{Element: element, Count: count})
+	}
+	slices.SortFunc(result, func(a, b Counted[T]) int {
+		return cmp.Compare(b.Count, a.Count)
+	})
+	return result
+}
+
+// TimestampedSet is a set observed at a point in time.
+type TimestampedSet[T comparable] struct {
+	Time time.Time
+	Set  Set[T]
+}
+
+// WindowedIntersection groups the items into consecutive, non-overlapping time windows and returns
+// the intersection of the sets falling into each window.
+//
+// Windows are aligned to the earliest timestamp t0: window i covers [t0 + i*window, t0 + (i+1)*window).
+// The result has one entry per window from the first to the last non-empty one; windows that contain
+// no sets yield an empty set. Items need not be sorted. It panics if window is not positive.
+//
+// Time complexity: O(N + W) where N is the total number of elements across all sets and W is the number of windows.
+func WindowedIntersection[T comparable](items []TimestampedSet[T], window time.Duration) []Set[T] {
+	if window <= 0 {
+		panic("goset: non-positive window duration")
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	start := items[0].Time
+	for _, item := range items[1:] {
+		if item.Time.Before(start) {
+			start = item.Time
+		}
+	}
+	var result []Set[T]
+	var seen []bool
+	for _, item := range items {
+		i := int(item.Time.Sub(start) / window)
+		for len(result) <= i {
+			result = append(result, NewHashSet[T]())
+			seen = append(seen, false)
+		}
+		if seen[i] {
+			result[i].Retain(item.Set)
+		} else {
+			result[i].Merge(item.Set)
+			seen[i] = true
+		}
+	}
+	return result
+}
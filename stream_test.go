@@ -0,0 +1,89 @@
+package goset
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestWindowedDistinct(t *testing.T) {
+	w := WindowedDistinct[string](3)
+	steps := []struct {
+		push string
+		want int
+	}{
+		{"a", 1},
+		{"b", 2},
+		{"a", 2}, // window: a b a
+		{"c", 3}, // window: b a c
+		{"c", 2}, // window: a c c
+		{"c", 1}, // window: c c c
+		{"d", 2}, // window: c c d
+	}
+	for i, step := range steps {
+		w.Push(step.push)
+		if got := w.Count(); got != step.want {
+			t.Errorf("after push %d (%q): Count = %d, want %d", i, step.push, got, step.want)
+		}
+	}
+}
+
+func TestTopK(t *testing.T) {
+	// 100 items: "a" occurs 50 times, "b" 30 times and 20 distinct noise items once each.
+	// With k = 4, every element occurring more than 100/4 times is guaranteed to be tracked.
+	top := NewTopK[string](4)
+	for i := range 50 {
+		top.Add("a")
+		if i < 30 {
+			top.Add("b")
+		}
+		if i < 20 {
+			top.Add(fmt.Sprintf("noise%d", i))
+		}
+	}
+
+	counts := make(map[string]int)
+	for _, c := range top.Top() {
+		counts[c.Element] = c.Count
+	}
+	if len(counts) > 4 {
+		t.Errorf("Top returned %d elements, want at most 4", len(counts))
+	}
+	if counts["a"] < 50 || counts["b"] < 30 {
+		t.Errorf("Top counts = %v, want a >= 50 and b >= 30", counts)
+	}
+	if first := top.Top()[0]; first.Element != "a" {
+		t.Errorf("Top()[0] = %v, want a", first)
+	}
+}
+
+func TestWindowedIntersection(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	items := []TimestampedSet[string]{
+		// Unsorted input; windows are aligned to the earliest timestamp t0.
+		{Time: t0.Add(70 * time.Second), Set: NewHashSet("x", "y")},
+		{Time: t0, Set: NewHashSet("a", "b", "c")},
+		{Time: t0.Add(30 * time.Second), Set: NewHashSet("b", "c", "d")},
+		{Time: t0.Add(59 * time.Second), Set: NewHashSet("c", "b")},
+		{Time: t0.Add(180 * time.Second), Set: NewHashSet("z")},
+	}
+	windows := WindowedIntersection(items, time.Minute)
+	want := [][]string{
+		{"b", "c"}, // [t0, t0+1m)
+		{"x", "y"}, // [t0+1m, t0+2m)
+		nil,        // [t0+2m, t0+3m) has no sets
+		{"z"},      // [t0+3m, t0+4m)
+	}
+	if len(windows) != len(want) {
+		t.Fatalf("WindowedIntersection returned %d windows, want %d", len(windows), len(want))
+	}
+	for i, elements := range want {
+		if got := sortedElements(windows[i]); !slices.Equal(got, elements) {
+			t.Errorf("window %d = %v, want %v", i, got, elements)
+		}
+	}
+	if got := WindowedIntersection[string](nil, time.Minute); got != nil {
+		t.Errorf("WindowedIntersection(nil) = %v, want nil", got)
+	}
+}
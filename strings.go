@@ -0,0 +1,22 @@
+package goset
+
+import (
+	"strings"
+)
+
+// WithPrefix returns a new set containing the elements of s that start with the prefix.
+// An empty prefix matches every element.
+//
+// Go does not allow methods on a specific instantiation of a generic type,
+// so this is a function rather than a method on HashSet[string].
+//
+// Time complexity: O(n) where n is the size of the set.
+func WithPrefix(s Set[string], prefix string) Set[string] {
+	newset := NewHashSet[string]()
+	for element := range s.All() {
+		if strings.HasPrefix(element, prefix) {
+			newset.Add(element)
+		}
+	}
+	return newset
+}
@@ -0,0 +1,24 @@
+package goset
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestWithPrefix(t *testing.T) {
+	s := NewHashSet("user:1", "user:2", "admin:1", "username")
+	tests := []struct {
+		prefix string
+		want   []string
+	}{
+		{"user:", []string{"user:1", "user:2"}},
+		{"user", []string{"user:1", "user:2", "username"}},
+		{"guest:", nil},
+		{"", []string{"admin:1", "user:1", "user:2", "username"}},
+	}
+	for _, tt := range tests {
+		if got := sortedElements(WithPrefix(s, tt.prefix)); !slices.Equal(got, tt.want) {
+			t.Errorf("WithPrefix(%q) = %v, want %v", tt.prefix, got, tt.want)
+		}
+	}
+}
@@ -179,6 +179,13 @@ func (set *SyncSet[T]) Len() int {
 	return set.Set.Len()
 }
 
+// IsEmpty reports whether the set contains no elements. Read-locked.
+func (set *SyncSet[T]) IsEmpty() bool {
+	set.mu.RLock()
+	defer set.mu.RUnlock()
+	return set.Set.IsEmpty()
+}
+
 // String returns a human-readable representation in the format "Set{e1, e2, ...}". Read-locked.
 func (set *SyncSet[T]) String() string {
 	set.mu.RLock()
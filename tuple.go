@@ -0,0 +1,24 @@
+package goset
+
+// Tuple2 is a comparable pair of values, usable as a composite set element.
+type Tuple2[A, B comparable] struct {
+	First  A
+	Second B
+}
+
+// Tuple3 is a comparable triple of values, usable as a composite set element.
+type Tuple3[A, B, C comparable] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// T2 creates a Tuple2 from two values.
+func T2[A, B comparable](a A, b B) Tuple2[A, B] {
+	return Tuple2[A, B]{First: a, Second: b}
+}
+
+// T3 creates a Tuple3 from three values.
+func T3[A, B, C comparable](a A, b B, c C) Tuple3[A, B, C] {
+	return Tuple3[A, B, C]{First: a, Second: b, Third: c}
+}
@@ -0,0 +1,23 @@
+package goset
+
+import (
+	"testing"
+)
+
+func TestTupleSet(t *testing.T) {
+	set := NewHashSet(T2(1, "x"), T2(2, "y"), T2(1, "x"))
+	if set.Len() != 2 {
+		t.Errorf("Len = %d, want 2", set.Len())
+	}
+	if !set.Contains(T2(1, "x")) {
+		t.Error("Contains(T2(1, \"x\")) = false, want true")
+	}
+	if set.Contains(T2(1, "y")) {
+		t.Error("Contains(T2(1, \"y\")) = true, want false")
+	}
+
+	triples := NewHashSet(T3(1, "a", true))
+	if !triples.Contains(Tuple3[int, string, bool]{First: 1, Second: "a", Third: true}) {
+		t.Error("T3 and a Tuple3 literal with the same fields are not equal")
+	}
+}
@@ -0,0 +1,61 @@
+package goset
+
+// ValidatingSet is a wrapper that rejects elements failing a validation function.
+// Use TryAdd to insert an element and receive the validation error. Add, Merge, and Xor
+// cannot report errors through their signatures, so they panic with the validation error instead.
+//
+// The zero value is not usable - use NewValidatingSet to create instances.
+type ValidatingSet[T comparable] struct {
+	Set[T]
+	validate func(T) error
+}
+
+// NewValidatingSet creates a new ValidatingSet wrapping the provided Set.
+// Elements already present in the set are not validated.
+func NewValidatingSet[T comparable](set Set[T], validate func(T) error) *ValidatingSet[T] {
+	return &ValidatingSet[T]{
+		Set:      set,
+		validate: validate,
+	}
+}
+
+// TryAdd inserts the element into the set if it passes validation, otherwise returns the validation error.
+func (set *ValidatingSet[T]) TryAdd(element T) error {
+	if err := set.validate(element); err != nil {
+		return err
+	}
+	set.Set.Add(element)
+	return nil
+}
+
+// Add inserts the element into the set. It panics with the validation error if the element is invalid.
+func (set *ValidatingSet[T]) Add(element T) {
+	if err := set.TryAdd(element); err != nil {
+		panic(err)
+	}
+}
+
+// Merge adds all elements from the other set to this set (in-place union).
+// All elements are validated before any is added; it panics with the first validation error.
+func (set *ValidatingSet[T]) Merge(other Set[T]) {
+	for element := range other.All() {
+		if err := set.validate(element); err != nil {
+			panic(err)
+		}
+	}
+	set.Set.Merge(other)
+}
+
+// Xor replaces this set with elements present in exactly one set (in-place symmetric difference).
+// Elements that would be added are validated first; it panics with the first validation error.
+func (set *ValidatingSet[T]) Xor(other Set[T]) {
+	for element := range other.All() {
+		if set.Set.Contains(element) {
+			continue
+		}
+		if err := set.validate(element); err != nil {
+			panic(err)
+		}
+	}
+	set.Set.Xor(other)
+}
@@ -0,0 +1,65 @@
+package goset
+
+import (
+	"errors"
+	"testing"
+)
+
+var errNegative = errors.New("negative number")
+
+func nonNegative(n int) error {
+	if n < 0 {
+		return errNegative
+	}
+	return nil
+}
+
+// recoverError runs f and returns the error it panicked with, or nil if it did not panic.
+func recoverError(f func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err, _ = r.(error)
+		}
+	}()
+	f()
+	return nil
+}
+
+func TestValidatingSetTryAdd(t *testing.T) {
+	s := NewValidatingSet[int](NewHashSet[int](), nonNegative)
+	if err := s.TryAdd(1); err != nil {
+		t.Errorf("TryAdd(1) = %v, want nil", err)
+	}
+	if err := s.TryAdd(-1); !errors.Is(err, errNegative) {
+		t.Errorf("TryAdd(-1) = %v, want errNegative", err)
+	}
+	if want := NewHashSet(1); !s.Equals(want) {
+		t.Errorf("after TryAdd: %v, want %v", s, want)
+	}
+}
+
+func TestValidatingSetPanics(t *testing.T) {
+	s := NewValidatingSet[int](NewHashSet(1, -5), nonNegative)
+	s.Add(2)
+
+	if err := recoverError(func() { s.Add(-1) }); !errors.Is(err, errNegative) {
+		t.Errorf("Add(-1) panicked with %v, want errNegative", err)
+	}
+	if err := recoverError(func() { s.Merge(NewHashSet(3, -2)) }); !errors.Is(err, errNegative) {
+		t.Errorf("Merge with an invalid element panicked with %v, want errNegative", err)
+	}
+	if err := recoverError(func() { s.Xor(NewHashSet(4, -3)) }); !errors.Is(err, errNegative) {
+		t.Errorf("Xor with an invalid element panicked with %v, want errNegative", err)
+	}
+	if want := NewHashSet(1, -5, 2); !s.Equals(want) {
+		t.Errorf("after rejected operations: %v, want %v", s, want)
+	}
+
+	// Xor only validates elements it would add, so removing the pre-existing -5 is allowed.
+	if err := recoverError(func() { s.Xor(NewHashSet(-5, 3)) }); err != nil {
+		t.Errorf("Xor removing an existing element panicked with %v", err)
+	}
+	if want := NewHashSet(1, 2, 3); !s.Equals(want) {
+		t.Errorf("after Xor: %v, want %v", s, want)
+	}
+}